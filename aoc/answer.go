@@ -0,0 +1,11 @@
+package aoc
+
+import "strconv"
+
+// Answer is the solution of one part of a puzzle, as it would be entered on
+// the website.
+type Answer string
+
+func Int[T Integer](n T) Answer {
+	return Answer(strconv.FormatInt(int64(n), 10))
+}
@@ -0,0 +1,134 @@
+// Package aoctest runs the registered solvers of a day against example inputs
+// with known answers and benchmarks them.
+//
+// To compare two revisions run the benchmarks several times on each and feed
+// both outputs to benchstat:
+//
+//	go test -run NONE -bench . -count 10 ./day5 > old.txt
+//	go test -run NONE -bench . -count 10 ./day5 > new.txt
+//	benchstat old.txt new.txt
+package aoctest
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"aoc2023/aoc"
+)
+
+// Example is an input file of a day together with the expected answer for
+// one part. The file name is relative to the file system given to Run and
+// Benchmark, usually the embedded example files of the day.
+type Example struct {
+	File string
+	Part int
+	Want aoc.Answer
+}
+
+// InputsEnv names the environment variable with the directory of the real
+// inputs. By default the inputs directory of the repository is used.
+const InputsEnv = "AOC_INPUTS"
+
+// lookup returns a solver that parses the input and solves the part.
+func lookup(tb testing.TB, day, part int) aoc.Solver {
+	tb.Helper()
+
+	puzzle, ok := aoc.Lookup(day)
+	if !ok {
+		tb.Fatalf("day %v is not registered", day)
+	}
+	if part < 1 || part > len(puzzle.Parts) {
+		tb.Fatalf("day %v has no part %v", day, part)
+	}
+
+	return func(r io.Reader) (aoc.Answer, error) {
+		return puzzle.Solve(part, r)
+	}
+}
+
+// Run solves all examples with the solvers registered for day.
+func Run(t *testing.T, day int, files fs.FS, examples []Example) {
+	t.Helper()
+
+	for _, example := range examples {
+		name := fmt.Sprintf("%v/part%v", example.File, example.Part)
+
+		t.Run(name, func(t *testing.T) {
+			solver := lookup(t, day, example.Part)
+
+			input, err := fs.ReadFile(files, example.File)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			got, err := solver(bytes.NewReader(input))
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got != example.Want {
+				t.Errorf("got %v, want %v", got, example.Want)
+			}
+		})
+	}
+}
+
+// realInput returns the real input of day if there is one.
+func realInput(day int) ([]byte, bool) {
+	dir := os.Getenv(InputsEnv)
+	if dir == "" {
+		// tests run in the directory of the day
+		dir = filepath.Join("..", "inputs")
+	}
+
+	input, err := os.ReadFile(filepath.Join(dir, fmt.Sprintf("day%v.txt", day)))
+	if err != nil {
+		return nil, false
+	}
+	return input, true
+}
+
+// Benchmark solves one part of day with every example of that part and with
+// the real input, if available. Each input is a sub-benchmark named after the
+// file, so that results of different revisions can be compared with benchstat.
+func Benchmark(b *testing.B, day, part int, files fs.FS, examples []Example) {
+	b.Helper()
+
+	solver := lookup(b, day, part)
+
+	for _, example := range examples {
+		if example.Part != part {
+			continue
+		}
+
+		input, err := fs.ReadFile(files, example.File)
+		if err != nil {
+			b.Fatal(err)
+		}
+
+		b.Run(example.File, func(b *testing.B) {
+			benchmarkInput(b, solver, input)
+		})
+	}
+
+	if input, ok := realInput(day); ok {
+		b.Run("input", func(b *testing.B) {
+			benchmarkInput(b, solver, input)
+		})
+	}
+}
+
+func benchmarkInput(b *testing.B, solver aoc.Solver, input []byte) {
+	b.ReportAllocs()
+	b.SetBytes(int64(len(input)))
+
+	for i := 0; i < b.N; i++ {
+		if _, err := solver(bytes.NewReader(input)); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
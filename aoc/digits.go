@@ -0,0 +1,17 @@
+package aoc
+
+var digitMap = map[rune]int{
+	'0': 0, '1': 1, '2': 2, '3': 3, '4': 4,
+	'5': 5, '6': 6, '7': 7, '8': 8, '9': 9,
+}
+
+// Digit returns the value of a decimal digit.
+func Digit(r rune) (int, bool) {
+	d, ok := digitMap[r]
+	return d, ok
+}
+
+func IsDigit(r rune) bool {
+	_, ok := digitMap[r]
+	return ok
+}
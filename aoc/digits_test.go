@@ -0,0 +1,24 @@
+package aoc
+
+import "testing"
+
+func TestDigit(t *testing.T) {
+	for i, r := range "0123456789" {
+		d, ok := Digit(r)
+		if !ok || d != i {
+			t.Errorf("Digit(%q) = %v, %v, want %v, true", r, d, ok, i)
+		}
+		if !IsDigit(r) {
+			t.Errorf("IsDigit(%q) = false, want true", r)
+		}
+	}
+
+	for _, r := range "a.*-+ Z" {
+		if _, ok := Digit(r); ok {
+			t.Errorf("Digit(%q) is ok, want not ok", r)
+		}
+		if IsDigit(r) {
+			t.Errorf("IsDigit(%q) = true, want false", r)
+		}
+	}
+}
@@ -0,0 +1,83 @@
+package aoc
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// ParseError describes invalid puzzle input. Line and Column start at 1, a
+// value of 0 means that the position is not known.
+type ParseError struct {
+	Day    int
+	Line   int
+	Column int
+	Text   string // the offending line
+	Err    error
+}
+
+func (e *ParseError) Error() string {
+	var sb strings.Builder
+
+	if e.Day > 0 {
+		fmt.Fprintf(&sb, "day %v: ", e.Day)
+	}
+	if e.Line > 0 {
+		fmt.Fprintf(&sb, "line %v: ", e.Line)
+	}
+	if e.Column > 0 {
+		fmt.Fprintf(&sb, "column %v: ", e.Column)
+	}
+	sb.WriteString(e.Err.Error())
+
+	return sb.String()
+}
+
+func (e *ParseError) Unwrap() error {
+	return e.Err
+}
+
+// Errorf returns a ParseError for the given column. The line is added by the
+// caller with AtLine.
+func Errorf(column int, format string, args ...any) error {
+	return &ParseError{
+		Column: column,
+		Err:    fmt.Errorf(format, args...),
+	}
+}
+
+// AtLine adds the line number and the text of the line to err. Errors that
+// are not a ParseError are wrapped into one.
+func AtLine(line int, text string, err error) error {
+	if err == nil {
+		return nil
+	}
+
+	var pe *ParseError
+	if !errors.As(err, &pe) {
+		pe = &ParseError{Err: err}
+	}
+
+	pe.Line = line
+	pe.Text = text
+	return pe
+}
+
+// WithOffset moves the column of err by offset. It is used when a part of a
+// line was parsed separately.
+func WithOffset(offset int, err error) error {
+	var pe *ParseError
+	if errors.As(err, &pe) && pe.Column > 0 {
+		pe.Column += offset
+	}
+	return err
+}
+
+// withDay sets the day of a ParseError that has none yet.
+func withDay(day int, err error) error {
+	var pe *ParseError
+	if errors.As(err, &pe) && pe.Day == 0 {
+		pe.Day = day
+	}
+	return err
+}
@@ -0,0 +1,82 @@
+package aoc
+
+import (
+	"errors"
+	"io"
+	"strings"
+	"testing"
+)
+
+func TestParseErrorMessage(t *testing.T) {
+	tests := []struct {
+		err  *ParseError
+		want string
+	}{
+		{&ParseError{Err: io.EOF}, "EOF"},
+		{&ParseError{Day: 5, Line: 3, Column: 4, Err: io.EOF}, "day 5: line 3: column 4: EOF"},
+		{&ParseError{Day: 5, Line: 3, Err: io.EOF}, "day 5: line 3: EOF"},
+	}
+
+	for _, test := range tests {
+		if got := test.err.Error(); got != test.want {
+			t.Errorf("Error() = %q, want %q", got, test.want)
+		}
+	}
+}
+
+func TestAtLine(t *testing.T) {
+	if AtLine(1, "", nil) != nil {
+		t.Errorf("AtLine with a nil error is not nil")
+	}
+
+	err := AtLine(7, "some line", WithOffset(10, Errorf(2, "bad")))
+
+	var pe *ParseError
+	if !errors.As(err, &pe) {
+		t.Fatalf("AtLine did not return a ParseError")
+	}
+	if pe.Line != 7 || pe.Column != 12 || pe.Text != "some line" {
+		t.Errorf("got line %v column %v text %q, want line 7 column 12 text \"some line\"", pe.Line, pe.Column, pe.Text)
+	}
+
+	err = AtLine(2, "other", io.ErrUnexpectedEOF)
+	if !errors.As(err, &pe) || pe.Line != 2 || pe.Column != 0 {
+		t.Errorf("AtLine did not wrap a plain error into a ParseError")
+	}
+	if !errors.Is(err, io.ErrUnexpectedEOF) {
+		t.Errorf("wrapped error is lost")
+	}
+}
+
+func TestPuzzleAddsDay(t *testing.T) {
+	parse := func(r io.Reader) (string, error) {
+		return "", AtLine(1, "x", Errorf(1, "bad parse"))
+	}
+	part := func(input string) (Answer, error) {
+		return "", AtLine(1, "x", Errorf(1, "bad"))
+	}
+	puzzle := newPuzzle(42, parse, part)
+
+	var pe *ParseError
+	if _, err := puzzle.Parse(strings.NewReader("")); !errors.As(err, &pe) || pe.Day != 42 {
+		t.Errorf("Parse: got %v, want a ParseError for day 42", err)
+	}
+	if _, err := puzzle.Parts[0](""); !errors.As(err, &pe) || pe.Day != 42 {
+		t.Errorf("part 1: got %v, want a ParseError for day 42", err)
+	}
+
+	err := withDay(42, AtLine(1, "x", &ParseError{Day: 7, Err: io.EOF}))
+	if !errors.As(err, &pe) || pe.Day != 7 {
+		t.Errorf("withDay replaced day 7: got %v", err)
+	}
+}
+
+func TestRegisterInvalidDay(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Errorf("RegisterParsed(0) did not panic")
+		}
+	}()
+
+	RegisterParsed(0, ReadLines)
+}
@@ -0,0 +1,3 @@
+module aoc2023/aoc
+
+go 1.21
@@ -0,0 +1,27 @@
+package aoc
+
+import (
+	"strconv"
+	"strings"
+)
+
+// ParseInts returns the integers in str that are separated by whitespace.
+// Every token has to be an integer.
+func ParseInts(str string) ([]int, error) {
+	result := make([]int, 0)
+
+	column := 0
+	for _, token := range strings.Fields(str) {
+		column += strings.Index(str[column:], token)
+
+		number, err := strconv.Atoi(token)
+		if err != nil {
+			return nil, Errorf(column+1, "invalid number %q", token)
+		}
+
+		result = append(result, number)
+		column += len(token)
+	}
+
+	return result, nil
+}
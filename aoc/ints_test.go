@@ -0,0 +1,42 @@
+package aoc
+
+import (
+	"errors"
+	"slices"
+	"testing"
+)
+
+func TestParseInts(t *testing.T) {
+	tests := []struct {
+		str    string
+		want   []int
+		column int
+	}{
+		{"", []int{}, 0},
+		{" 83 86  6 31", []int{83, 86, 6, 31}, 0},
+		{"0 -3 -6", []int{0, -3, -6}, 0},
+		{"1 2 x 3", nil, 5},
+		{"  12 1x", nil, 6},
+		{"7 7 77x", nil, 5},
+	}
+
+	for _, test := range tests {
+		got, err := ParseInts(test.str)
+
+		if test.column == 0 {
+			if err != nil {
+				t.Errorf("ParseInts(%q) failed: %v", test.str, err)
+			} else if !slices.Equal(got, test.want) {
+				t.Errorf("ParseInts(%q) = %v, want %v", test.str, got, test.want)
+			}
+			continue
+		}
+
+		var pe *ParseError
+		if !errors.As(err, &pe) {
+			t.Errorf("ParseInts(%q) error = %v, want a ParseError", test.str, err)
+		} else if pe.Column != test.column {
+			t.Errorf("ParseInts(%q) error column = %v, want %v", test.str, pe.Column, test.column)
+		}
+	}
+}
@@ -0,0 +1,27 @@
+package aoc
+
+import (
+	"bufio"
+	"io"
+)
+
+// maxLineLength is the longest line ReadLines accepts. The default of
+// bufio.Scanner (64 KiB) is too small for some generated inputs.
+const maxLineLength = 1024 * 1024
+
+// ReadLines reads all lines from r without the line endings.
+func ReadLines(r io.Reader) ([]string, error) {
+	s := bufio.NewScanner(r)
+	s.Buffer(make([]byte, 0, 4096), maxLineLength)
+
+	result := make([]string, 0)
+	for s.Scan() {
+		result = append(result, s.Text())
+	}
+
+	if err := s.Err(); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
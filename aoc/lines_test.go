@@ -0,0 +1,42 @@
+package aoc
+
+import (
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestReadLines(t *testing.T) {
+	tests := []struct {
+		input string
+		want  []string
+	}{
+		{"", []string{}},
+		{"a", []string{"a"}},
+		{"a\nb\n", []string{"a", "b"}},
+		{"a\r\n\r\nb", []string{"a", "", "b"}},
+	}
+
+	for _, test := range tests {
+		got, err := ReadLines(strings.NewReader(test.input))
+		if err != nil {
+			t.Errorf("ReadLines(%q) failed: %v", test.input, err)
+			continue
+		}
+		if !slices.Equal(got, test.want) {
+			t.Errorf("ReadLines(%q) = %q, want %q", test.input, got, test.want)
+		}
+	}
+}
+
+func TestReadLinesLong(t *testing.T) {
+	line := strings.Repeat("x", 100000)
+
+	got, err := ReadLines(strings.NewReader(line + "\n" + line))
+	if err != nil {
+		t.Fatalf("ReadLines failed: %v", err)
+	}
+	if len(got) != 2 || got[0] != line || got[1] != line {
+		t.Errorf("ReadLines did not return the two long lines")
+	}
+}
@@ -0,0 +1,67 @@
+package aoc
+
+import "math"
+
+type Integer interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64
+}
+
+// GCD returns the greatest common divisor of a and b.
+func GCD[T Integer](a, b T) T {
+	for b != 0 {
+		a, b = b, a%b
+	}
+
+	if a < 0 {
+		return -a
+	}
+	return a
+}
+
+// LCM returns the least common multiple of a and b.
+func LCM[T Integer](a, b T) T {
+	if a == 0 || b == 0 {
+		return 0
+	}
+
+	// divide first to reduce the chance of an overflow
+	result := a / GCD(a, b) * b
+	if result < 0 {
+		return -result
+	}
+	return result
+}
+
+// LCMOf returns the least common multiple of all numbers.
+func LCMOf[T Integer](numbers ...T) T {
+	if len(numbers) == 0 {
+		return 0
+	}
+
+	result := numbers[0]
+	for _, n := range numbers[1:] {
+		result = LCM(result, n)
+	}
+	return result
+}
+
+// ISqrt returns the largest integer r with r*r <= n. It panics if n is
+// negative.
+func ISqrt[T Integer](n T) T {
+	if n < 0 {
+		panic("square root of a negative number")
+	}
+	if n == 0 {
+		return 0
+	}
+
+	// the float estimate is off by a few for large numbers
+	r := T(math.Sqrt(float64(n)))
+	for r > n/r {
+		r--
+	}
+	for r+1 <= n/(r+1) {
+		r++
+	}
+	return r
+}
@@ -0,0 +1,85 @@
+package aoc
+
+import (
+	"math"
+	"testing"
+)
+
+func TestGCD(t *testing.T) {
+	tests := []struct {
+		a, b, want int
+	}{
+		{0, 0, 0},
+		{5, 0, 5},
+		{0, 5, 5},
+		{12, 18, 6},
+		{18, 12, 6},
+		{17, 5, 1},
+		{-12, 18, 6},
+	}
+
+	for _, test := range tests {
+		if got := GCD(test.a, test.b); got != test.want {
+			t.Errorf("GCD(%v, %v) = %v, want %v", test.a, test.b, got, test.want)
+		}
+	}
+}
+
+func TestLCM(t *testing.T) {
+	tests := []struct {
+		a, b, want int64
+	}{
+		{0, 5, 0},
+		{4, 6, 12},
+		{7, 13, 91},
+		{-4, 6, 12},
+		{21, 6, 42},
+	}
+
+	for _, test := range tests {
+		if got := LCM(test.a, test.b); got != test.want {
+			t.Errorf("LCM(%v, %v) = %v, want %v", test.a, test.b, got, test.want)
+		}
+	}
+}
+
+func TestLCMOf(t *testing.T) {
+	if got := LCMOf[int](); got != 0 {
+		t.Errorf("LCMOf() = %v, want 0", got)
+	}
+	if got := LCMOf(7); got != 7 {
+		t.Errorf("LCMOf(7) = %v, want 7", got)
+	}
+	if got := LCMOf(2, 3, 4, 5); got != 60 {
+		t.Errorf("LCMOf(2, 3, 4, 5) = %v, want 60", got)
+	}
+}
+
+func TestISqrt(t *testing.T) {
+	tests := []struct {
+		n, want int
+	}{
+		{0, 0},
+		{1, 1},
+		{3, 1},
+		{4, 2},
+		{99, 9},
+		{100, 10},
+		{1<<62 - 1, 1<<31 - 1},
+		{1 << 62, 1 << 31},
+		{math.MaxInt, 3037000499},
+	}
+
+	for _, test := range tests {
+		if got := ISqrt(test.n); got != test.want {
+			t.Errorf("ISqrt(%v) = %v, want %v", test.n, got, test.want)
+		}
+	}
+
+	for n := int8(0); n < math.MaxInt8; n++ {
+		r := ISqrt(n)
+		if int(r)*int(r) > int(n) || int(r+1)*int(r+1) <= int(n) {
+			t.Errorf("ISqrt(%v) = %v", n, r)
+		}
+	}
+}
@@ -0,0 +1,86 @@
+package aoc
+
+import (
+	"fmt"
+	"io"
+	"slices"
+)
+
+// Solver solves one part of a puzzle reading the puzzle input from r.
+type Solver func(r io.Reader) (Answer, error)
+
+// Part solves one part of a puzzle from the result of Puzzle.Parse.
+type Part func(input any) (Answer, error)
+
+type Puzzle struct {
+	Day int
+	// Parse reads the input once for all parts. The parts must not modify
+	// its result, because it is shared by all of them.
+	Parse func(r io.Reader) (any, error)
+	Parts []Part
+}
+
+// Solve parses the input and solves one part of the puzzle.
+func (p Puzzle) Solve(part int, r io.Reader) (Answer, error) {
+	input, err := p.Parse(r)
+	if err != nil {
+		return "", err
+	}
+
+	return p.Parts[part-1](input)
+}
+
+var puzzles = make(map[int]Puzzle)
+
+// RegisterParsed makes the parts of a day available to the runner. The input
+// is parsed once by parse and the result is passed to every part. It is meant
+// to be called from the init function of each day. A ParseError returned by
+// parse or one of the parts is annotated with the day.
+func RegisterParsed[T any](day int, parse func(r io.Reader) (T, error), parts ...func(input T) (Answer, error)) {
+	if day < 1 {
+		panic(fmt.Sprintf("aoc: invalid day %v", day))
+	}
+	if _, ok := puzzles[day]; ok {
+		panic(fmt.Sprintf("aoc: day %v registered twice", day))
+	}
+
+	puzzles[day] = newPuzzle(day, parse, parts...)
+}
+
+// newPuzzle hides the type of the parsed input behind Puzzle.
+func newPuzzle[T any](day int, parse func(r io.Reader) (T, error), parts ...func(input T) (Answer, error)) Puzzle {
+	puzzle := Puzzle{
+		Day: day,
+		Parse: func(r io.Reader) (any, error) {
+			input, err := parse(r)
+			return input, withDay(day, err)
+		},
+		Parts: make([]Part, len(parts)),
+	}
+
+	for i, part := range parts {
+		part := part
+		puzzle.Parts[i] = func(input any) (Answer, error) {
+			answer, err := part(input.(T))
+			return answer, withDay(day, err)
+		}
+	}
+
+	return puzzle
+}
+
+func Lookup(day int) (Puzzle, bool) {
+	p, ok := puzzles[day]
+	return p, ok
+}
+
+// Days returns all registered days in ascending order.
+func Days() []int {
+	result := make([]int, 0, len(puzzles))
+	for day := range puzzles {
+		result = append(result, day)
+	}
+	slices.Sort(result)
+
+	return result
+}
@@ -0,0 +1,159 @@
+package main
+
+import (
+	"bufio"
+	"cmp"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	"aoc2023/aoc"
+)
+
+// answerKey identifies the answer of one part for one particular input. The
+// input is identified by its SHA-256 hash, so that different accounts and
+// example inputs can be stored side by side.
+type answerKey struct {
+	day  int
+	part int
+	hash string
+}
+
+// knownAnswers is stored as a small subset of TOML:
+//
+//	[day5.part1]
+//	<sha256 of the input> = "35"
+type knownAnswers map[answerKey]aoc.Answer
+
+type verdict int
+
+const (
+	verdictNew verdict = iota + 1
+	verdictPass
+	verdictFail
+)
+
+func (v verdict) String() string {
+	switch v {
+	case verdictNew:
+		return "NEW"
+	case verdictPass:
+		return "PASS"
+	case verdictFail:
+		return "FAIL"
+	}
+	return "?"
+}
+
+var (
+	tableRegex = regexp.MustCompile(`^\[day([0-9]+)\.part([0-9]+)\]$`)
+	entryRegex = regexp.MustCompile(`^([0-9a-f]+)\s*=\s*(".*")$`)
+)
+
+func inputHash(input []byte) string {
+	sum := sha256.Sum256(input)
+	return hex.EncodeToString(sum[:])
+}
+
+// loadKnownAnswers reads the answers file. A missing file is not an error and
+// results in an empty store.
+func loadKnownAnswers(name string) (knownAnswers, error) {
+	result := make(knownAnswers)
+
+	file, err := os.Open(name)
+	if errors.Is(err, fs.ErrNotExist) {
+		return result, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	s := bufio.NewScanner(file)
+
+	day, part := 0, 0
+	for lineNo := 1; s.Scan(); lineNo++ {
+		line := strings.TrimSpace(s.Text())
+
+		if len(line) == 0 || line[0] == '#' {
+			continue
+		}
+
+		if parts := tableRegex.FindStringSubmatch(line); parts != nil {
+			day, _ = strconv.Atoi(parts[1])
+			part, _ = strconv.Atoi(parts[2])
+			continue
+		}
+
+		parts := entryRegex.FindStringSubmatch(line)
+		if parts == nil {
+			return nil, fmt.Errorf("%v:%v: invalid line %q", name, lineNo, line)
+		}
+		if day == 0 {
+			return nil, fmt.Errorf("%v:%v: answer outside of a [dayN.partM] table", name, lineNo)
+		}
+
+		value, err := strconv.Unquote(parts[2])
+		if err != nil {
+			return nil, fmt.Errorf("%v:%v: invalid answer %v", name, lineNo, parts[2])
+		}
+
+		result[answerKey{day, part, parts[1]}] = aoc.Answer(value)
+	}
+
+	if err := s.Err(); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+func (k knownAnswers) save(name string) error {
+	keys := make([]answerKey, 0, len(k))
+	for key := range k {
+		keys = append(keys, key)
+	}
+	slices.SortFunc(keys, func(a, b answerKey) int {
+		if a.day != b.day {
+			return cmp.Compare(a.day, b.day)
+		}
+		if a.part != b.part {
+			return cmp.Compare(a.part, b.part)
+		}
+		return strings.Compare(a.hash, b.hash)
+	})
+
+	var sb strings.Builder
+	sb.WriteString("# known answers of the aoc runner, keyed by the SHA-256 of the input\n")
+
+	prevDay, prevPart := 0, 0
+	for _, key := range keys {
+		if key.day != prevDay || key.part != prevPart {
+			fmt.Fprintf(&sb, "\n[day%v.part%v]\n", key.day, key.part)
+			prevDay, prevPart = key.day, key.part
+		}
+		fmt.Fprintf(&sb, "%v = %v\n", key.hash, strconv.Quote(string(k[key])))
+	}
+
+	return os.WriteFile(name, []byte(sb.String()), 0o644)
+}
+
+// check compares answer with the known answer. The second return value is the
+// known answer in case of a failure.
+func (k knownAnswers) check(key answerKey, answer aoc.Answer) (verdict, aoc.Answer) {
+	known, ok := k[key]
+	if !ok {
+		return verdictNew, ""
+	}
+	if known != answer {
+		return verdictFail, known
+	}
+	return verdictPass, known
+}
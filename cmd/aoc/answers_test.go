@@ -0,0 +1,83 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"aoc2023/aoc"
+)
+
+func TestKnownAnswersRoundTrip(t *testing.T) {
+	name := filepath.Join(t.TempDir(), "answers.toml")
+
+	answers, err := loadKnownAnswers(name)
+	if err != nil {
+		t.Fatalf("loading a missing file failed: %v", err)
+	}
+	if len(answers) != 0 {
+		t.Fatalf("missing file resulted in %v answers", len(answers))
+	}
+
+	hash := inputHash([]byte("input"))
+	answers[answerKey{5, 1, hash}] = "35"
+	answers[answerKey{5, 2, hash}] = "46"
+	answers[answerKey{10, 1, hash}] = "a \"quoted\" answer"
+
+	if err := answers.save(name); err != nil {
+		t.Fatal(err)
+	}
+
+	loaded, err := loadKnownAnswers(name)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(loaded) != len(answers) {
+		t.Fatalf("loaded %v answers, want %v", len(loaded), len(answers))
+	}
+	for key, want := range answers {
+		if got := loaded[key]; got != want {
+			t.Errorf("answer of %v = %q, want %q", key, got, want)
+		}
+	}
+}
+
+func TestKnownAnswersCheck(t *testing.T) {
+	key := answerKey{1, 1, inputHash(nil)}
+	answers := knownAnswers{key: "142"}
+
+	tests := []struct {
+		key    answerKey
+		answer aoc.Answer
+		want   verdict
+	}{
+		{key, "142", verdictPass},
+		{key, "143", verdictFail},
+		{answerKey{1, 2, key.hash}, "281", verdictNew},
+	}
+
+	for _, test := range tests {
+		if got, _ := answers.check(test.key, test.answer); got != test.want {
+			t.Errorf("check(%v, %v) = %v, want %v", test.key, test.answer, got, test.want)
+		}
+	}
+}
+
+func TestLoadKnownAnswersInvalid(t *testing.T) {
+	tests := []string{
+		"abc = \"1\"\n",
+		"[day1.part1]\nnot an entry\n",
+		"[day1.part1]\nabc = \"unterminated\n",
+	}
+
+	for _, content := range tests {
+		name := filepath.Join(t.TempDir(), "answers.toml")
+		if err := os.WriteFile(name, []byte(content), 0o644); err != nil {
+			t.Fatal(err)
+		}
+
+		if _, err := loadKnownAnswers(name); err == nil {
+			t.Errorf("loading %q succeeded, want error", content)
+		}
+	}
+}
@@ -0,0 +1,3 @@
+module aoc2023/cmd/aoc
+
+go 1.21
@@ -0,0 +1,332 @@
+package main
+
+import (
+	"bytes"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"aoc2023/aoc"
+
+	_ "aoc2023/day1"
+	_ "aoc2023/day2"
+	_ "aoc2023/day3"
+	_ "aoc2023/day4"
+	_ "aoc2023/day5"
+	_ "aoc2023/day6"
+	_ "aoc2023/day7"
+	_ "aoc2023/day8"
+	_ "aoc2023/day9"
+)
+
+const usage = `usage:
+  aoc list
+  aoc run [flags] <days> [<parts>]
+
+<days> is "all" or a comma separated list of days and ranges, e.g. "1,3,5-7".
+<parts> is "all" (default) or a comma separated list of parts.
+
+The input of day N is read from <inputs>/dayN.txt unless -input is given.
+
+Every answer is compared with the known answers for the same input and
+reported as PASS, FAIL or NEW. With -record new answers are added to the
+known answers.
+
+With -stats the time, allocations and peak heap of parsing the input and of
+every part are reported. The peak heap is the growth of the heap during a
+phase, it does not include the heap of the runner and of earlier phases. With
+-repeat every part is solved several times and the time is reported as
+min/median/max.
+
+With -format json or -format tsv one record per part is written with the
+fields day, part, answer, duration (in ns), input, status, allocs,
+alloc_bytes and peak_heap. Errors are still reported on stderr.
+`
+
+func parseSelection(str string, all []int) ([]int, error) {
+	if str == "all" {
+		return all, nil
+	}
+
+	result := make([]int, 0)
+	for _, token := range strings.Split(str, ",") {
+		from, to, isRange := strings.Cut(token, "-")
+
+		first, err := strconv.Atoi(from)
+		if err != nil {
+			return nil, fmt.Errorf("invalid selection %q", token)
+		}
+
+		last := first
+		if isRange {
+			last, err = strconv.Atoi(to)
+			if err != nil || last < first {
+				return nil, fmt.Errorf("invalid selection %q", token)
+			}
+		}
+
+		for i := first; i <= last; i++ {
+			result = append(result, i)
+		}
+	}
+
+	return result, nil
+}
+
+// inputCache keeps stdin for all selected days.
+type inputCache struct {
+	stdin     []byte
+	stdinErr  error
+	stdinRead bool
+}
+
+// readInput loads the whole input of a day, so that every part gets its own
+// reader over the same data. stdin can only be read once, so it is read by the
+// first call and the same data is returned for every later day.
+func (c *inputCache) readInput(name string) ([]byte, error) {
+	if name != "-" {
+		return os.ReadFile(name)
+	}
+
+	if !c.stdinRead {
+		c.stdin, c.stdinErr = io.ReadAll(os.Stdin)
+		c.stdinRead = true
+	}
+	return c.stdin, c.stdinErr
+}
+
+type result struct {
+	day     int
+	part    int
+	input   string
+	answer  aoc.Answer
+	runs    []measurement
+	verdict verdict
+	known   aoc.Answer
+}
+
+// runPart solves a part repeat times from the parsed input. The answer of the
+// first run is used.
+func runPart(puzzle aoc.Puzzle, part int, input any, repeat int) (result, error) {
+	r := result{
+		day:  puzzle.Day,
+		part: part,
+	}
+
+	for i := 0; i < repeat; i++ {
+		var answer aoc.Answer
+		var err error
+
+		m := measure(func() {
+			answer, err = puzzle.Parts[part-1](input)
+		})
+		if err != nil {
+			return result{}, err
+		}
+
+		if i == 0 {
+			r.answer = answer
+		}
+		r.runs = append(r.runs, m)
+	}
+
+	return r, nil
+}
+
+// printError reports a failed part, or a failed parse if part is 0. Parse
+// errors are printed like compiler diagnostics with the position in the input
+// and the offending line.
+func printError(input string, day, part int, err error) {
+	phase := fmt.Sprintf("day %v part %v", day, part)
+	if part == 0 {
+		phase = fmt.Sprintf("day %v", day)
+	}
+
+	var pe *aoc.ParseError
+	if !errors.As(err, &pe) {
+		fmt.Fprintf(os.Stderr, "%v: %v: %v\n", input, phase, err)
+		return
+	}
+
+	pos := input
+	if pe.Line > 0 {
+		pos += fmt.Sprintf(":%v", pe.Line)
+		if pe.Column > 0 {
+			pos += fmt.Sprintf(":%v", pe.Column)
+		}
+	}
+	fmt.Fprintf(os.Stderr, "%v: %v: %v\n", pos, phase, pe.Err)
+
+	if pe.Line > 0 && pe.Text != "" {
+		fmt.Fprintf(os.Stderr, "\t%v\n", pe.Text)
+		if pe.Column > 0 {
+			fmt.Fprintf(os.Stderr, "\t%v^\n", strings.Repeat(" ", pe.Column-1))
+		}
+	}
+}
+
+func run(args []string) error {
+	flags := flag.NewFlagSet("run", flag.ExitOnError)
+	inputDir := flags.String("inputs", "inputs", "directory containing the dayN.txt input files")
+	inputFile := flags.String("input", "", "input file for all selected days, \"-\" for stdin")
+	answersFile := flags.String("answers", "answers.toml", "file with the known answers")
+	record := flags.Bool("record", false, "add new answers to the known answers")
+	stats := flags.Bool("stats", false, "report time and memory usage of every phase")
+	repeat := flags.Int("repeat", 1, "solve every part `N` times")
+	format := flags.String("format", "text", "output format: text, json or tsv")
+	flags.Parse(args)
+
+	if flags.NArg() < 1 || flags.NArg() > 2 {
+		return fmt.Errorf("run expects days and optionally parts")
+	}
+
+	if *repeat < 1 {
+		return fmt.Errorf("-repeat has to be at least 1")
+	}
+
+	days, err := parseSelection(flags.Arg(0), aoc.Days())
+	if err != nil {
+		return err
+	}
+
+	out, err := newOutput(*format, os.Stdout, *stats)
+	if err != nil {
+		return err
+	}
+
+	answers, err := loadKnownAnswers(*answersFile)
+	if err != nil {
+		return err
+	}
+
+	partSelection := "all"
+	if flags.NArg() == 2 {
+		partSelection = flags.Arg(1)
+	}
+
+	var inputs inputCache
+
+	numNew, numFailed, numErrors := 0, 0, 0
+	for _, day := range days {
+		puzzle, ok := aoc.Lookup(day)
+		if !ok {
+			return fmt.Errorf("day %v is not implemented", day)
+		}
+
+		allParts := make([]int, len(puzzle.Parts))
+		for i := range allParts {
+			allParts[i] = i + 1
+		}
+
+		parts, err := parseSelection(partSelection, allParts)
+		if err != nil {
+			return err
+		}
+
+		name := *inputFile
+		if name == "" {
+			name = filepath.Join(*inputDir, fmt.Sprintf("day%v.txt", day))
+		}
+
+		input, err := inputs.readInput(name)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "day %v: %v\n", day, err)
+			numErrors++
+			continue
+		}
+		hash := inputHash(input)
+
+		if name == "-" {
+			name = "<stdin>"
+		}
+
+		var parsed any
+		parseStats := measure(func() {
+			parsed, err = puzzle.Parse(bytes.NewReader(input))
+		})
+		if err != nil {
+			printError(name, day, 0, err)
+			numErrors++
+			continue
+		}
+		out.parse(day, name, parseStats)
+
+		for _, part := range parts {
+			if part < 1 || part > len(puzzle.Parts) {
+				return fmt.Errorf("day %v has no part %v", day, part)
+			}
+
+			r, err := runPart(puzzle, part, parsed, *repeat)
+			if err != nil {
+				printError(name, day, part, err)
+				numErrors++
+				continue
+			}
+			r.input = name
+
+			key := answerKey{day, part, hash}
+			r.verdict, r.known = answers.check(key, r.answer)
+			out.result(r)
+
+			switch r.verdict {
+			case verdictNew:
+				answers[key] = r.answer
+				numNew++
+			case verdictFail:
+				numFailed++
+			}
+		}
+	}
+
+	if err := out.flush(); err != nil {
+		return err
+	}
+
+	if *record && numNew > 0 {
+		if err := answers.save(*answersFile); err != nil {
+			return err
+		}
+	}
+
+	if numErrors > 0 {
+		return fmt.Errorf("%v errors", numErrors)
+	}
+
+	if numFailed > 0 {
+		return fmt.Errorf("%v answers differ from the known answers", numFailed)
+	}
+
+	return nil
+}
+
+func list() {
+	for _, day := range aoc.Days() {
+		puzzle, _ := aoc.Lookup(day)
+		fmt.Printf("day %v: %v parts\n", day, len(puzzle.Parts))
+	}
+}
+
+func main() {
+	if len(os.Args) < 2 {
+		fmt.Fprint(os.Stderr, usage)
+		os.Exit(2)
+	}
+
+	switch os.Args[1] {
+	case "list":
+		list()
+	case "run":
+		if err := run(os.Args[2:]); err != nil {
+			fmt.Fprintf(os.Stderr, "aoc: %v\n", err)
+			os.Exit(1)
+		}
+	default:
+		fmt.Fprint(os.Stderr, usage)
+		os.Exit(2)
+	}
+}
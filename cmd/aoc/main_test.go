@@ -0,0 +1,35 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestReadInputStdinOnce(t *testing.T) {
+	name := filepath.Join(t.TempDir(), "stdin")
+	if err := os.WriteFile(name, []byte("1abc2\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	f, err := os.Open(name)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+
+	stdin := os.Stdin
+	os.Stdin = f
+	defer func() { os.Stdin = stdin }()
+
+	var inputs inputCache
+	for day := 1; day <= 3; day++ {
+		input, err := inputs.readInput("-")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(input) != "1abc2\n" {
+			t.Errorf("day %v got %q from stdin", day, input)
+		}
+	}
+}
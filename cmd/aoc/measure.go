@@ -0,0 +1,145 @@
+package main
+
+import (
+	"cmp"
+	"fmt"
+	"runtime"
+	"runtime/metrics"
+	"slices"
+	"time"
+)
+
+// measurement holds the cost of a single run of a phase, e.g. parsing the
+// input or solving a part. peakHeap is the largest growth of the heap over its
+// size at the start of the phase.
+type measurement struct {
+	elapsed    time.Duration
+	allocBytes uint64
+	allocs     uint64
+	peakHeap   uint64
+}
+
+// sampleInterval is how often the heap size is sampled while a phase runs.
+// Peaks that are shorter than this can be missed.
+const sampleInterval = time.Millisecond
+
+// heapMetric is used for sampling, because unlike runtime.ReadMemStats it
+// does not stop the world.
+const heapMetric = "/memory/classes/heap/objects:bytes"
+
+func readMemStats() (allocBytes, allocs, heap uint64) {
+	var stats runtime.MemStats
+	runtime.ReadMemStats(&stats)
+
+	return stats.TotalAlloc, stats.Mallocs, stats.HeapAlloc
+}
+
+// sampleHeap records the largest heap size until done is closed and then
+// sends it to peak.
+func sampleHeap(done <-chan struct{}, peak chan<- uint64) {
+	ticker := time.NewTicker(sampleInterval)
+	defer ticker.Stop()
+
+	sample := []metrics.Sample{{Name: heapMetric}}
+	result := uint64(0)
+	for {
+		select {
+		case <-done:
+			peak <- result
+			return
+		case <-ticker.C:
+			metrics.Read(sample)
+			result = max(result, sample[0].Value.Uint64())
+		}
+	}
+}
+
+// measure runs f once. A garbage collection is forced beforehand so that
+// garbage of earlier phases does not count towards the peak heap.
+func measure(f func()) measurement {
+	runtime.GC()
+
+	startBytes, startAllocs, startHeap := readMemStats()
+
+	done := make(chan struct{})
+	peak := make(chan uint64)
+	go sampleHeap(done, peak)
+
+	start := time.Now()
+	f()
+	elapsed := time.Since(start)
+
+	close(done)
+	sampledHeap := <-peak
+	endBytes, endAllocs, endHeap := readMemStats()
+
+	return measurement{
+		elapsed:    elapsed,
+		allocBytes: endBytes - startBytes,
+		allocs:     endAllocs - startAllocs,
+		peakHeap:   max(startHeap, sampledHeap, endHeap) - startHeap,
+	}
+}
+
+// spread returns the minimum, median and maximum of values.
+func spread[T cmp.Ordered](values []T) (T, T, T) {
+	sorted := slices.Clone(values)
+	slices.Sort(sorted)
+
+	return sorted[0], sorted[len(sorted)/2], sorted[len(sorted)-1]
+}
+
+// summarize returns the field-wise minimum, median and maximum of runs.
+func summarize(runs []measurement) (lo, median, hi measurement) {
+	field := func(get func(m measurement) uint64) (uint64, uint64, uint64) {
+		values := make([]uint64, len(runs))
+		for i, m := range runs {
+			values[i] = get(m)
+		}
+		return spread(values)
+	}
+
+	elapsed := make([]time.Duration, len(runs))
+	for i, m := range runs {
+		elapsed[i] = m.elapsed
+	}
+	lo.elapsed, median.elapsed, hi.elapsed = spread(elapsed)
+
+	lo.allocBytes, median.allocBytes, hi.allocBytes = field(func(m measurement) uint64 { return m.allocBytes })
+	lo.allocs, median.allocs, hi.allocs = field(func(m measurement) uint64 { return m.allocs })
+	lo.peakHeap, median.peakHeap, hi.peakHeap = field(func(m measurement) uint64 { return m.peakHeap })
+
+	return
+}
+
+func formatBytes(n uint64) string {
+	const unit = 1024
+	if n < unit {
+		return fmt.Sprintf("%v B", n)
+	}
+
+	value := float64(n)
+	suffix := ""
+	for _, s := range []string{"KiB", "MiB", "GiB", "TiB"} {
+		value /= unit
+		suffix = s
+		if value < unit {
+			break
+		}
+	}
+	return fmt.Sprintf("%.1f %v", value, suffix)
+}
+
+// formatStats describes the runs of a phase in a single line. With more than
+// one run the time is given as min/median/max and the memory as median.
+func formatStats(runs []measurement) string {
+	lo, median, hi := summarize(runs)
+
+	elapsed := median.elapsed.String()
+	if len(runs) > 1 {
+		elapsed = fmt.Sprintf("%v / %v / %v (min/median/max of %v runs)", lo.elapsed, median.elapsed, hi.elapsed, len(runs))
+	}
+
+	return fmt.Sprintf("time %v, %v allocs (%v), peak heap %v",
+		elapsed, median.allocs, formatBytes(median.allocBytes), formatBytes(median.peakHeap))
+}
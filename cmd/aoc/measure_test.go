@@ -0,0 +1,57 @@
+package main
+
+import (
+	"testing"
+	"time"
+)
+
+func TestSummarize(t *testing.T) {
+	runs := []measurement{
+		{elapsed: 3 * time.Millisecond, allocs: 10, allocBytes: 100, peakHeap: 7},
+		{elapsed: 1 * time.Millisecond, allocs: 30, allocBytes: 300, peakHeap: 5},
+		{elapsed: 2 * time.Millisecond, allocs: 20, allocBytes: 200, peakHeap: 6},
+	}
+
+	lo, median, hi := summarize(runs)
+
+	if lo.elapsed != time.Millisecond || median.elapsed != 2*time.Millisecond || hi.elapsed != 3*time.Millisecond {
+		t.Errorf("elapsed = %v / %v / %v, want 1ms / 2ms / 3ms", lo.elapsed, median.elapsed, hi.elapsed)
+	}
+	if lo.allocs != 10 || median.allocs != 20 || hi.allocs != 30 {
+		t.Errorf("allocs = %v / %v / %v, want 10 / 20 / 30", lo.allocs, median.allocs, hi.allocs)
+	}
+	if median.allocBytes != 200 || median.peakHeap != 6 {
+		t.Errorf("median bytes %v, peak %v, want 200 and 6", median.allocBytes, median.peakHeap)
+	}
+}
+
+func TestFormatBytes(t *testing.T) {
+	tests := []struct {
+		n    uint64
+		want string
+	}{
+		{0, "0 B"},
+		{1023, "1023 B"},
+		{1024, "1.0 KiB"},
+		{1536, "1.5 KiB"},
+		{6 << 30, "6.0 GiB"},
+	}
+
+	for _, test := range tests {
+		if got := formatBytes(test.n); got != test.want {
+			t.Errorf("formatBytes(%v) = %q, want %q", test.n, got, test.want)
+		}
+	}
+}
+
+func TestMeasureCountsAllocations(t *testing.T) {
+	var sink []byte
+	m := measure(func() {
+		sink = make([]byte, 1<<20)
+	})
+
+	if m.allocBytes < 1<<20 || m.peakHeap < 1<<20 {
+		t.Errorf("got %v allocated and %v peak heap, want at least 1 MiB", m.allocBytes, m.peakHeap)
+	}
+	_ = sink
+}
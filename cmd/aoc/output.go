@@ -0,0 +1,147 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+
+	"aoc2023/aoc"
+)
+
+// output writes the results of the runner in one of the supported formats.
+type output interface {
+	// parse reports the cost of parsing the input of a day.
+	parse(day int, input string, m measurement)
+	result(r result)
+	// flush is called once after all results.
+	flush() error
+}
+
+func newOutput(format string, w io.Writer, stats bool) (output, error) {
+	switch format {
+	case "text":
+		return &textOutput{w: w, stats: stats}, nil
+	case "json":
+		return &jsonOutput{enc: json.NewEncoder(w)}, nil
+	case "tsv":
+		return &tsvOutput{w: w}, nil
+	}
+	return nil, fmt.Errorf("unknown format %q, expected text, json or tsv", format)
+}
+
+type textOutput struct {
+	w     io.Writer
+	stats bool
+}
+
+func (o *textOutput) parse(day int, input string, m measurement) {
+	if o.stats {
+		fmt.Fprintf(o.w, "day %v parse: %v\n", day, formatStats([]measurement{m}))
+	}
+}
+
+func (o *textOutput) result(r result) {
+	_, median, _ := summarize(r.runs)
+
+	fmt.Fprintf(o.w, "day %v part %v: %v (%v) %v", r.day, r.part, r.answer, median.elapsed, r.verdict)
+	if r.verdict == verdictFail {
+		fmt.Fprintf(o.w, ", expected %v", r.known)
+	}
+	fmt.Fprintln(o.w)
+
+	if o.stats {
+		fmt.Fprintf(o.w, "\t%v\n", formatStats(r.runs))
+	}
+}
+
+func (o *textOutput) flush() error {
+	return nil
+}
+
+// record is a single result in the machine-readable formats. The duration
+// is the median over all runs in nanoseconds, the memory statistics are the
+// median as well.
+type record struct {
+	Day        int        `json:"day"`
+	Part       int        `json:"part"`
+	Answer     aoc.Answer `json:"answer"`
+	Duration   int64      `json:"duration"`
+	Input      string     `json:"input"`
+	Status     string     `json:"status"`
+	Allocs     uint64     `json:"allocs"`
+	AllocBytes uint64     `json:"alloc_bytes"`
+	PeakHeap   uint64     `json:"peak_heap"`
+}
+
+func newRecord(r result) record {
+	_, median, _ := summarize(r.runs)
+
+	return record{
+		Day:        r.day,
+		Part:       r.part,
+		Answer:     r.answer,
+		Duration:   median.elapsed.Nanoseconds(),
+		Input:      r.input,
+		Status:     r.verdict.String(),
+		Allocs:     median.allocs,
+		AllocBytes: median.allocBytes,
+		PeakHeap:   median.peakHeap,
+	}
+}
+
+// jsonOutput writes one JSON object per line.
+type jsonOutput struct {
+	enc *json.Encoder
+	err error
+}
+
+func (o *jsonOutput) parse(day int, input string, m measurement) {}
+
+func (o *jsonOutput) result(r result) {
+	if o.err == nil {
+		o.err = o.enc.Encode(newRecord(r))
+	}
+}
+
+func (o *jsonOutput) flush() error {
+	return o.err
+}
+
+// tsvOutput writes tab separated values with a header line.
+type tsvOutput struct {
+	w             io.Writer
+	headerWritten bool
+}
+
+var tsvHeader = []string{"day", "part", "answer", "duration", "input", "status", "allocs", "alloc_bytes", "peak_heap"}
+
+func (o *tsvOutput) parse(day int, input string, m measurement) {}
+
+func (o *tsvOutput) result(r result) {
+	if !o.headerWritten {
+		fmt.Fprintln(o.w, strings.Join(tsvHeader, "\t"))
+		o.headerWritten = true
+	}
+
+	rec := newRecord(r)
+	fields := []any{rec.Day, rec.Part, tsvEscape(string(rec.Answer)), rec.Duration,
+		tsvEscape(rec.Input), rec.Status, rec.Allocs, rec.AllocBytes, rec.PeakHeap}
+
+	for i, f := range fields {
+		if i > 0 {
+			fmt.Fprint(o.w, "\t")
+		}
+		fmt.Fprint(o.w, f)
+	}
+	fmt.Fprintln(o.w)
+}
+
+func (o *tsvOutput) flush() error {
+	return nil
+}
+
+// tsvEscape replaces the characters that cannot appear in a TSV field.
+func tsvEscape(s string) string {
+	return strings.NewReplacer("\\", "\\\\", "\t", "\\t", "\n", "\\n", "\r", "\\r").Replace(s)
+}
@@ -0,0 +1,69 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+)
+
+var testResult = result{
+	day:     5,
+	part:    2,
+	input:   "inputs/day5.txt",
+	answer:  "46",
+	runs:    []measurement{{elapsed: 3 * time.Microsecond, allocs: 12, allocBytes: 340, peakHeap: 5600}},
+	verdict: verdictPass,
+}
+
+func TestJSONOutput(t *testing.T) {
+	var buf bytes.Buffer
+	out, err := newOutput("json", &buf, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	out.result(testResult)
+	if err := out.flush(); err != nil {
+		t.Fatal(err)
+	}
+
+	var got record
+	if err := json.Unmarshal(buf.Bytes(), &got); err != nil {
+		t.Fatalf("invalid JSON %q: %v", buf.String(), err)
+	}
+
+	want := record{5, 2, "46", 3000, "inputs/day5.txt", "PASS", 12, 340, 5600}
+	if got != want {
+		t.Errorf("got %+v, want %+v", got, want)
+	}
+}
+
+func TestTSVOutput(t *testing.T) {
+	var buf bytes.Buffer
+	out, err := newOutput("tsv", &buf, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	out.result(testResult)
+	out.result(testResult)
+
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	if len(lines) != 3 {
+		t.Fatalf("got %v lines, want a header and two records", len(lines))
+	}
+	if lines[0] != strings.Join(tsvHeader, "\t") {
+		t.Errorf("header = %q", lines[0])
+	}
+	if want := "5\t2\t46\t3000\tinputs/day5.txt\tPASS\t12\t340\t5600"; lines[1] != want {
+		t.Errorf("record = %q, want %q", lines[1], want)
+	}
+}
+
+func TestUnknownFormat(t *testing.T) {
+	if _, err := newOutput("xml", &bytes.Buffer{}, false); err == nil {
+		t.Errorf("unknown format accepted")
+	}
+}
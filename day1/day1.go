@@ -1,22 +1,12 @@
-package main
+package day1
 
-import (
-	"bufio"
-	"fmt"
-	"log"
-	"os"
-)
+import "aoc2023/aoc"
 
 type Pair struct {
 	n string
 	d int
 }
 
-var digitMap = map[rune]int{
-	'0': 0, '1': 1, '2': 2, '3': 3, '4': 4,
-	'5': 5, '6': 6, '7': 7, '8': 8, '9': 9,
-}
-
 var digitNames = []Pair{
 	{"one", 1}, {"two", 2}, {"three", 3}, {"four", 4},
 	{"five", 5}, {"six", 6}, {"seven", 7}, {"eight", 8},
@@ -25,7 +15,7 @@ var digitNames = []Pair{
 
 func toDigitWithNames(line string) (int, bool) {
 
-	if digit, ok := digitMap[rune(line[0])]; ok {
+	if digit, ok := aoc.Digit(rune(line[0])); ok {
 		return digit, true
 	}
 
@@ -43,7 +33,7 @@ func toDigitWithNames(line string) (int, bool) {
 func processLine1(line string) (a, b int) {
 	first := true
 	for _, c := range line {
-		digit, ok := digitMap[c]
+		digit, ok := aoc.Digit(c)
 		if !ok {
 			continue
 		}
@@ -81,60 +71,32 @@ func processLine2(line string) (a, b int) {
 	return
 }
 
-func part1() {
-	file, err := os.Open("input")
-	if err != nil {
-		log.Fatal(err)
-		return
-	}
-	defer file.Close()
-
-	s := bufio.NewScanner(file)
-
+// Solve1 returns the sum of all calibration values made of the first and last
+// digit of each line.
+func Solve1(lines []string) (aoc.Answer, error) {
 	sum := 0
-	for s.Scan() {
-		line := s.Text()
-
+	for _, line := range lines {
 		a, b := processLine1(line)
 
 		sum += a*10 + b
 	}
 
-	fmt.Printf("part 1 sum: %v\n", sum)
-
-	if err = s.Err(); err != nil {
-		log.Fatal(err)
-		return
-	}
+	return aoc.Int(sum), nil
 }
 
-func part2() {
-	file, err := os.Open("input")
-	if err != nil {
-		log.Fatal(err)
-		return
-	}
-	defer file.Close()
-
-	s := bufio.NewScanner(file)
-
+// Solve2 returns the sum of all calibration values where digits may also be
+// spelled out.
+func Solve2(lines []string) (aoc.Answer, error) {
 	sum := 0
-	for s.Scan() {
-		line := s.Text()
+	for _, line := range lines {
 		a, b := processLine2(line)
 
 		sum += a*10 + b
 	}
 
-	fmt.Printf("part 2 sum: %v\n", sum)
-
-	if err = s.Err(); err != nil {
-		log.Fatal(err)
-		return
-	}
+	return aoc.Int(sum), nil
 }
 
-func main() {
-	part1()
-	part2()
+func init() {
+	aoc.RegisterParsed(1, aoc.ReadLines, Solve1, Solve2)
 }
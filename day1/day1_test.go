@@ -0,0 +1,28 @@
+package day1
+
+import (
+	"embed"
+	"testing"
+
+	"aoc2023/aoc/aoctest"
+)
+
+//go:embed example*
+var exampleFiles embed.FS
+
+var examples = []aoctest.Example{
+	{File: "example1", Part: 1, Want: "142"},
+	{File: "example2", Part: 2, Want: "281"},
+}
+
+func TestExamples(t *testing.T) {
+	aoctest.Run(t, 1, exampleFiles, examples)
+}
+
+func BenchmarkDay1Part1(b *testing.B) {
+	aoctest.Benchmark(b, 1, 1, exampleFiles, examples)
+}
+
+func BenchmarkDay1Part2(b *testing.B) {
+	aoctest.Benchmark(b, 1, 2, exampleFiles, examples)
+}
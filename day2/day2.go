@@ -0,0 +1,192 @@
+package day2
+
+import (
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"aoc2023/aoc"
+)
+
+const MAX_RED = 12
+const MAX_GREEN = 13
+const MAX_BLUE = 14
+
+func getGameID(s string) (int, error) {
+	gameRegex := regexp.MustCompile(`^Game ([0-9]+)`)
+
+	parts := gameRegex.FindStringSubmatch(s)
+	if parts == nil {
+		return 0, aoc.Errorf(1, "expected \"Game <id>\"")
+	}
+
+	id, err := strconv.Atoi(string(parts[1]))
+	if err != nil {
+		return 0, aoc.Errorf(len("Game ")+1, "invalid game ID %q", parts[1])
+	}
+	return id, nil
+}
+
+func getColors(set string) (int, int, int, error) {
+	redRegex := regexp.MustCompile(`([0-9]+) red`)
+	greenRegex := regexp.MustCompile(`([0-9]+) green`)
+	blueRegex := regexp.MustCompile(`([0-9]+) blue`)
+
+	redSum := 0
+	greenSum := 0
+	blueSum := 0
+
+	offset := 0
+	for _, color := range strings.Split(set, ",") {
+		column := offset + len(color) - len(strings.TrimLeft(color, " ")) + 1
+		offset += len(color) + 1
+
+		var sum *int
+		var parts []string
+		if redRegex.MatchString(color) {
+			parts = redRegex.FindStringSubmatch(color)
+			sum = &redSum
+		} else if greenRegex.MatchString(color) {
+			parts = greenRegex.FindStringSubmatch(color)
+			sum = &greenSum
+		} else if blueRegex.MatchString(color) {
+			parts = blueRegex.FindStringSubmatch(color)
+			sum = &blueSum
+		} else {
+			return 0, 0, 0, aoc.Errorf(column, "expected \"<count> red|green|blue\", got %q", strings.TrimSpace(color))
+		}
+
+		n, err := strconv.Atoi(parts[1])
+		if err != nil {
+			return 0, 0, 0, aoc.Errorf(column, "invalid number of cubes %q", parts[1])
+		}
+		*sum += n
+	}
+	return redSum, greenSum, blueSum, nil
+}
+
+// splitGame splits a line into the game ID and the sets of cubes. The offset
+// of each set within the line is returned for error reporting.
+func splitGame(line string) (id int, sets []string, offsets []int, err error) {
+	// 1. split : to get ID
+	// 2. split ; to get sets
+	// 3. split , to get colors (done in getColors)
+
+	str := strings.Split(line, ":")
+	if len(str) != 2 {
+		return 0, nil, nil, aoc.Errorf(1, "expected \"Game <id>: <sets>\"")
+	}
+
+	id, err = getGameID(str[0])
+	if err != nil {
+		return 0, nil, nil, err
+	}
+
+	offset := len(str[0]) + 1
+	for _, set := range strings.Split(str[1], ";") {
+		sets = append(sets, set)
+		offsets = append(offsets, offset)
+		offset += len(set) + 1
+	}
+
+	return id, sets, offsets, nil
+}
+
+// Cubes is the number of cubes of every color in one set of a game.
+type Cubes struct {
+	Red, Green, Blue int
+}
+
+type Game struct {
+	ID   int
+	Sets []Cubes
+}
+
+func parseGame(line string) (Game, error) {
+	id, sets, offsets, err := splitGame(line)
+	if err != nil {
+		return Game{}, err
+	}
+
+	game := Game{ID: id, Sets: make([]Cubes, 0, len(sets))}
+	for i, set := range sets {
+		r, g, b, err := getColors(set)
+		if err != nil {
+			return Game{}, aoc.WithOffset(offsets[i], err)
+		}
+
+		game.Sets = append(game.Sets, Cubes{r, g, b})
+	}
+
+	return game, nil
+}
+
+func readGames(r io.Reader) ([]Game, error) {
+	lines, err := aoc.ReadLines(r)
+	if err != nil {
+		return nil, err
+	}
+
+	games := make([]Game, 0, len(lines))
+	for i, line := range lines {
+		game, err := parseGame(line)
+		if err != nil {
+			return nil, aoc.AtLine(i+1, line, err)
+		}
+
+		games = append(games, game)
+	}
+
+	return games, nil
+}
+
+func isPossible(game Game) bool {
+	for _, set := range game.Sets {
+		if set.Red > MAX_RED || set.Green > MAX_GREEN || set.Blue > MAX_BLUE {
+			return false
+		}
+	}
+
+	return true
+}
+
+func power(game Game) int {
+	minRed := 0
+	minGreen := 0
+	minBlue := 0
+	for _, set := range game.Sets {
+		minRed = max(set.Red, minRed)
+		minGreen = max(set.Green, minGreen)
+		minBlue = max(set.Blue, minBlue)
+	}
+
+	return minRed * minGreen * minBlue
+}
+
+// Solve1 returns the sum of the IDs of all games that are possible with the
+// available cubes.
+func Solve1(games []Game) (aoc.Answer, error) {
+	sum := 0
+	for _, game := range games {
+		if isPossible(game) {
+			sum += game.ID
+		}
+	}
+
+	return aoc.Int(sum), nil
+}
+
+// Solve2 returns the sum of the powers of the minimal cube sets of all games.
+func Solve2(games []Game) (aoc.Answer, error) {
+	sum := 0
+	for _, game := range games {
+		sum += power(game)
+	}
+
+	return aoc.Int(sum), nil
+}
+
+func init() {
+	aoc.RegisterParsed(2, readGames, Solve1, Solve2)
+}
@@ -0,0 +1,36 @@
+package day2
+
+import (
+	"embed"
+	"testing"
+
+	"aoc2023/aoc/aoctest"
+)
+
+//go:embed example*
+var exampleFiles embed.FS
+
+var examples = []aoctest.Example{
+	{File: "example1", Part: 1, Want: "8"},
+	{File: "example2", Part: 2, Want: "2286"},
+}
+
+func TestExamples(t *testing.T) {
+	aoctest.Run(t, 2, exampleFiles, examples)
+}
+
+func BenchmarkDay2Part1(b *testing.B) {
+	aoctest.Benchmark(b, 2, 1, exampleFiles, examples)
+}
+
+func BenchmarkDay2Part2(b *testing.B) {
+	aoctest.Benchmark(b, 2, 2, exampleFiles, examples)
+}
+
+func BenchmarkGetColors(b *testing.B) {
+	set := " 1 green, 3 red, 6 blue"
+
+	for i := 0; i < b.N; i++ {
+		getColors(set)
+	}
+}
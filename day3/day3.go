@@ -1,35 +1,39 @@
-package main
+package day3
 
 import (
-	"bufio"
-	"fmt"
-	"log"
-	"os"
+	"io"
+
+	"aoc2023/aoc"
 )
 
 type Schematic []string
 
-func readSchematic(file *bufio.Scanner) Schematic {
+func readSchematic(r io.Reader) (Schematic, error) {
+	lines, err := aoc.ReadLines(r)
+	if err != nil {
+		return nil, err
+	}
+
 	result := make(Schematic, 0)
 	size := 0
 
-	for file.Scan() {
-		line := file.Text()
+	for i, line := range lines {
 		if size == 0 {
 			size = len(line)
 		} else {
 			if size != len(line) {
-				log.Fatal("readSchematic: Line size mismatch")
+				err := aoc.Errorf(min(size, len(line))+1, "line has %v cells, expected %v", len(line), size)
+				return nil, aoc.AtLine(i+1, line, err)
 			}
 		}
 		result = append(result, line)
 	}
 
-	if err := file.Err(); err != nil {
-		log.Fatal(err)
+	if size == 0 {
+		return nil, aoc.Errorf(0, "empty schematic")
 	}
 
-	return result
+	return result, nil
 }
 
 func (s Schematic) Width() int {
@@ -73,11 +77,6 @@ func (p *Pos) Next(skipX int, s Schematic) {
 	}
 }
 
-var digitMap = map[rune]int{
-	'0': 0, '1': 1, '2': 2, '3': 3, '4': 4,
-	'5': 5, '6': 6, '7': 7, '8': 8, '9': 9,
-}
-
 type CellType int
 
 const (
@@ -91,7 +90,7 @@ func runeToCellType(c rune) CellType {
 		return NONE
 	}
 
-	if _, ok := digitMap[c]; ok {
+	if aoc.IsDigit(c) {
 		return NUMBER
 	}
 
@@ -99,7 +98,7 @@ func runeToCellType(c rune) CellType {
 }
 
 func runeToDigit(r rune) int {
-	if d, ok := digitMap[r]; ok {
+	if d, ok := aoc.Digit(r); ok {
 		return d
 	}
 	return -1
@@ -301,49 +300,20 @@ func countGearRatios(schematic Schematic) int {
 	return sum
 }
 
-func part1() {
-	file, err := os.Open("input")
-	if err != nil {
-		log.Fatal(err)
-		return
-	}
-	defer file.Close()
-
-	s := bufio.NewScanner(file)
-
-	schematic := readSchematic(s)
+// Solve1 returns the sum of all part numbers adjacent to a symbol.
+func Solve1(schematic Schematic) (aoc.Answer, error) {
 	sum := countPartNumbers(schematic)
 
-	fmt.Printf("part 1 sum: %v\n", sum)
-
-	if err = s.Err(); err != nil {
-		log.Fatal(err)
-		return
-	}
+	return aoc.Int(sum), nil
 }
 
-func part2() {
-	file, err := os.Open("input")
-	if err != nil {
-		log.Fatal(err)
-		return
-	}
-	defer file.Close()
-
-	s := bufio.NewScanner(file)
-
-	schematic := readSchematic(s)
+// Solve2 returns the sum of all gear ratios.
+func Solve2(schematic Schematic) (aoc.Answer, error) {
 	sum := countGearRatios(schematic)
 
-	fmt.Printf("part 2 sum: %v\n", sum)
-
-	if err = s.Err(); err != nil {
-		log.Fatal(err)
-		return
-	}
+	return aoc.Int(sum), nil
 }
 
-func main() {
-	part1()
-	part2()
+func init() {
+	aoc.RegisterParsed(3, readSchematic, Solve1, Solve2)
 }
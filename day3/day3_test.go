@@ -0,0 +1,42 @@
+package day3
+
+import (
+	"embed"
+	"testing"
+
+	"aoc2023/aoc/aoctest"
+)
+
+//go:embed example*
+var exampleFiles embed.FS
+
+var examples = []aoctest.Example{
+	{File: "example1", Part: 1, Want: "4361"},
+	{File: "example1", Part: 2, Want: "467835"},
+}
+
+func TestExamples(t *testing.T) {
+	aoctest.Run(t, 3, exampleFiles, examples)
+}
+
+func BenchmarkDay3Part1(b *testing.B) {
+	aoctest.Benchmark(b, 3, 1, exampleFiles, examples)
+}
+
+func BenchmarkDay3Part2(b *testing.B) {
+	aoctest.Benchmark(b, 3, 2, exampleFiles, examples)
+}
+
+func BenchmarkSearchNumberGroup(b *testing.B) {
+	schematic := Schematic{
+		"467..114..",
+		"...*......",
+		"..35..633.",
+	}
+
+	for i := 0; i < b.N; i++ {
+		searchNumberGroup(schematic, Pos{2, 0})
+		searchNumberGroup(schematic, Pos{3, 2})
+		searchNumberGroup(schematic, Pos{3, 1})
+	}
+}
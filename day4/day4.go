@@ -0,0 +1,110 @@
+package day4
+
+import (
+	"io"
+	"slices"
+	"strings"
+
+	"aoc2023/aoc"
+)
+
+// Card is a scratchcard with the number of its winning numbers that we have.
+type Card struct {
+	Matches int
+	line    string
+}
+
+func points(card Card) int {
+	if card.Matches == 0 {
+		return 0
+	}
+
+	return 1 << (card.Matches - 1)
+}
+
+func parseCard(line string) (Card, error) {
+	card, numbers, ok := strings.Cut(line, ":")
+	if !ok {
+		return Card{}, aoc.Errorf(1, "expected \"Card <id>: <winning numbers> | <numbers>\"")
+	}
+
+	winning, have, ok := strings.Cut(numbers, "|")
+	if !ok {
+		return Card{}, aoc.Errorf(len(line)+1, "missing \"|\"")
+	}
+
+	winningNum, err := aoc.ParseInts(winning)
+	if err != nil {
+		return Card{}, aoc.WithOffset(len(card)+1, err)
+	}
+
+	haveNum, err := aoc.ParseInts(have)
+	if err != nil {
+		return Card{}, aoc.WithOffset(len(card)+1+len(winning)+1, err)
+	}
+
+	numMatches := 0
+	for _, w := range winningNum {
+		if slices.Contains(haveNum, w) {
+			numMatches++
+			continue
+		}
+	}
+
+	return Card{Matches: numMatches, line: line}, nil
+}
+
+func readCards(r io.Reader) ([]Card, error) {
+	lines, err := aoc.ReadLines(r)
+	if err != nil {
+		return nil, err
+	}
+
+	cards := make([]Card, 0, len(lines))
+	for i, line := range lines {
+		card, err := parseCard(line)
+		if err != nil {
+			return nil, aoc.AtLine(i+1, line, err)
+		}
+
+		cards = append(cards, card)
+	}
+
+	return cards, nil
+}
+
+// Solve1 returns the total points of all scratchcards.
+func Solve1(cards []Card) (aoc.Answer, error) {
+	sum := 0
+	for _, card := range cards {
+		sum += points(card)
+	}
+	return aoc.Int(sum), nil
+}
+
+// Solve2 returns the total number of scratchcards including all won copies.
+func Solve2(cards []Card) (aoc.Answer, error) {
+	for i, card := range cards {
+		if i+card.Matches >= len(cards) {
+			err := aoc.Errorf(0, "card wins copies of %v cards, but there are only %v cards after it", card.Matches, len(cards)-1-i)
+			return "", aoc.AtLine(i+1, card.line, err)
+		}
+	}
+
+	copiesTable := make([]int, len(cards))
+	for i, card := range cards {
+		numCopies := copiesTable[i] + 1
+		for j := 0; j < card.Matches; j++ {
+			copiesTable[i+1+j] += numCopies
+		}
+	}
+	sum := 0
+	for _, m := range copiesTable {
+		sum += m + 1 // +1 because we also have to count the originals and not only the copies
+	}
+	return aoc.Int(sum), nil
+}
+
+func init() {
+	aoc.RegisterParsed(4, readCards, Solve1, Solve2)
+}
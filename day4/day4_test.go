@@ -0,0 +1,44 @@
+package day4
+
+import (
+	"embed"
+	"errors"
+	"strings"
+	"testing"
+
+	"aoc2023/aoc"
+	"aoc2023/aoc/aoctest"
+)
+
+//go:embed example*
+var exampleFiles embed.FS
+
+var examples = []aoctest.Example{
+	{File: "example1", Part: 1, Want: "13"},
+	{File: "example1", Part: 2, Want: "30"},
+}
+
+func TestExamples(t *testing.T) {
+	aoctest.Run(t, 4, exampleFiles, examples)
+}
+
+func BenchmarkDay4Part1(b *testing.B) {
+	aoctest.Benchmark(b, 4, 1, exampleFiles, examples)
+}
+
+func BenchmarkDay4Part2(b *testing.B) {
+	aoctest.Benchmark(b, 4, 2, exampleFiles, examples)
+}
+
+func TestCopiesPastLastCard(t *testing.T) {
+	cards, err := readCards(strings.NewReader("Card 1: 1 2 | 3 4\nCard 2: 1 2 | 1 2\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = Solve2(cards)
+
+	var pe *aoc.ParseError
+	if !errors.As(err, &pe) || pe.Line != 2 {
+		t.Errorf("got %v, want a ParseError in line 2", err)
+	}
+}
@@ -0,0 +1,83 @@
+package day5
+
+import (
+	"runtime"
+	"sync"
+)
+
+// chunkSize is the number of seeds a worker maps before it takes the next
+// chunk.
+const chunkSize = 1 << 16
+
+// chunks splits the seed ranges into pieces of at most size seeds and sends
+// them to the returned channel.
+func chunks(seeds []Range, size int) <-chan Range {
+	ch := make(chan Range)
+
+	go func() {
+		defer close(ch)
+		for _, r := range seeds {
+			for start := r.Start; start < r.End; start += size {
+				ch <- Range{start, min(start+size, r.End)}
+			}
+		}
+	}()
+
+	return ch
+}
+
+// bruteForce maps every single seed with workers goroutines. Each worker keeps
+// its own minimum, ok is false if there are no seeds at all. At least one
+// worker is started, otherwise nobody would drain the chunks.
+func (a Almanac) bruteForce(seeds []Range, workers, size int) (lowest int, ok bool) {
+	type minimum struct {
+		value int
+		ok    bool
+	}
+
+	workers = max(workers, 1)
+
+	ch := chunks(seeds, size)
+	minima := make([]minimum, workers)
+
+	var wg sync.WaitGroup
+	for i := range minima {
+		wg.Add(1)
+		go func(m *minimum) {
+			defer wg.Done()
+			for chunk := range ch {
+				for seed := chunk.Start; seed < chunk.End; seed++ {
+					location := a.Location(seed)
+					if !m.ok || location < m.value {
+						m.value = location
+						m.ok = true
+					}
+				}
+			}
+		}(&minima[i])
+	}
+	wg.Wait()
+
+	for _, m := range minima {
+		if m.ok && (!ok || m.value < lowest) {
+			lowest = m.value
+			ok = true
+		}
+	}
+
+	return lowest, ok
+}
+
+// BruteForceLowestLocation is the original solution of part 2, which maps
+// every single seed. The seeds are streamed in chunks to all CPUs instead of
+// expanding the seed pairs, which would create ~6 GiB of seeds. It is kept to
+// cross-check the range based solution, see day5/cmd/bruteforce.
+func (a Almanac) BruteForceLowestLocation() (int, bool, error) {
+	seeds, err := seedRanges(a.Seeds)
+	if err != nil {
+		return 0, false, err
+	}
+
+	lowest, ok := a.bruteForce(seeds, runtime.NumCPU(), chunkSize)
+	return lowest, ok, nil
+}
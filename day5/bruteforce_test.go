@@ -0,0 +1,34 @@
+package day5
+
+import "testing"
+
+func TestBruteForce(t *testing.T) {
+	almanac := readExample(t)
+
+	seeds, err := seedRanges(almanac.Seeds)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		workers int
+		size    int
+	}{
+		{0, 3},
+		{1, chunkSize},
+		{1, 1},
+		{3, 7},
+		{8, 5},
+	}
+
+	for _, test := range tests {
+		lowest, ok := almanac.bruteForce(seeds, test.workers, test.size)
+		if !ok || lowest != 46 {
+			t.Errorf("%v workers, chunk size %v: got %v %v, want 46", test.workers, test.size, lowest, ok)
+		}
+	}
+
+	if _, ok := almanac.bruteForce([]Range{{5, 5}}, 2, 3); ok {
+		t.Errorf("found a location for no seeds")
+	}
+}
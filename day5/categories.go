@@ -0,0 +1,144 @@
+package day5
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"aoc2023/aoc"
+)
+
+const (
+	SeedCategory     = "seed"
+	LocationCategory = "location"
+)
+
+// Layer is one map of the almanac, converting from the Source category to
+// the Destination category.
+type Layer struct {
+	Source      string
+	Destination string
+	Mappings    Mappings
+
+	// Line is the line of the header in the almanac, 0 if it is not known.
+	Line int
+}
+
+func (l Layer) String() string {
+	return fmt.Sprintf("%v-to-%v", l.Source, l.Destination)
+}
+
+var headerRegex = regexp.MustCompile(`^([a-z]+)-to-([a-z]+) map:$`)
+
+func parseHeader(line string) (Layer, error) {
+	parts := headerRegex.FindStringSubmatch(line)
+	if parts == nil {
+		return Layer{}, aoc.Errorf(1, "expected \"<source>-to-<destination> map:\"")
+	}
+
+	if parts[1] == parts[2] {
+		return Layer{}, aoc.Errorf(1, "map from %v to itself", parts[1])
+	}
+
+	return Layer{Source: parts[1], Destination: parts[2]}, nil
+}
+
+// checkOrder makes sure that the layers form the chain from seed to location
+// in the order of the almanac. Every category may only be used once as a
+// source and once as a destination.
+func checkOrder(layers []Layer, lines []string) error {
+	expected := SeedCategory
+	sources := make(map[string]Layer)
+	destinations := make(map[string]Layer)
+
+	for _, layer := range layers {
+		text := lines[layer.Line-1]
+
+		if prev, ok := sources[layer.Source]; ok {
+			err := aoc.Errorf(1, "duplicated map from %v, first defined in line %v", layer.Source, prev.Line)
+			return aoc.AtLine(layer.Line, text, err)
+		}
+		if prev, ok := destinations[layer.Destination]; ok {
+			err := aoc.Errorf(1, "duplicated map to %v, first defined in line %v", layer.Destination, prev.Line)
+			return aoc.AtLine(layer.Line, text, err)
+		}
+		sources[layer.Source] = layer
+		destinations[layer.Destination] = layer
+
+		if layer.Source != expected {
+			err := aoc.Errorf(1, "expected a map from %v, got %v", expected, layer)
+			return aoc.AtLine(layer.Line, text, err)
+		}
+		expected = layer.Destination
+	}
+
+	if expected != LocationCategory {
+		return aoc.Errorf(0, "missing map from %v to %v", expected, LocationCategory)
+	}
+
+	return nil
+}
+
+// Categories returns all categories in the order of the almanac.
+func (a Almanac) Categories() []string {
+	if len(a.Layers) == 0 {
+		return nil
+	}
+
+	result := []string{a.Layers[0].Source}
+	for _, layer := range a.Layers {
+		result = append(result, layer.Destination)
+	}
+	return result
+}
+
+// Path returns the layers that convert from one category to another.
+func (a Almanac) Path(from, to string) ([]Layer, error) {
+	bySource := make(map[string]Layer)
+	for _, layer := range a.Layers {
+		bySource[layer.Source] = layer
+	}
+
+	result := make([]Layer, 0)
+	visited := map[string]bool{from: true}
+	for category := from; category != to; {
+		layer, ok := bySource[category]
+		if !ok {
+			return nil, fmt.Errorf("no map from %v on the way from %v to %v", category, from, to)
+		}
+
+		category = layer.Destination
+		if visited[category] {
+			return nil, fmt.Errorf("cycle at %v on the way from %v to %v", category, from, to)
+		}
+		visited[category] = true
+
+		result = append(result, layer)
+	}
+
+	return result, nil
+}
+
+// Convert returns the function that converts from one category to another,
+// e.g. from "soil" to "humidity".
+func (a Almanac) Convert(from, to string) (Function, error) {
+	path, err := a.Path(from, to)
+	if err != nil {
+		return nil, err
+	}
+
+	return Compose(mappingsOf(path)...), nil
+}
+
+func mappingsOf(layers []Layer) []Mappings {
+	result := make([]Mappings, len(layers))
+	for i, layer := range layers {
+		result[i] = layer.Mappings
+	}
+	return result
+}
+
+// String lists the categories of the almanac, e.g. "seed -> soil -> location".
+func (a Almanac) String() string {
+	return strings.Join(a.Categories(), " -> ")
+}
@@ -0,0 +1,75 @@
+package day5
+
+import (
+	"errors"
+	"slices"
+	"strings"
+	"testing"
+
+	"aoc2023/aoc"
+)
+
+func TestCategories(t *testing.T) {
+	almanac := readExample(t)
+
+	want := []string{"seed", "soil", "fertilizer", "water", "light", "temperature", "humidity", "location"}
+	if got := almanac.Categories(); !slices.Equal(got, want) {
+		t.Errorf("Categories() = %v, want %v", got, want)
+	}
+}
+
+func TestConvert(t *testing.T) {
+	almanac := readExample(t)
+
+	// seed 79 has soil 81 and humidity 78, see the puzzle description
+	f, err := almanac.Convert("soil", "humidity")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := f.Map(81); got != 78 {
+		t.Errorf("soil 81 has humidity %v, want 78", got)
+	}
+
+	f, err = almanac.Convert("seed", "seed")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := f.Map(79); got != 79 {
+		t.Errorf("seed to seed maps 79 to %v", got)
+	}
+
+	if _, err := almanac.Convert("humidity", "soil"); err == nil {
+		t.Errorf("conversion against the direction of the maps succeeded")
+	}
+	if _, err := almanac.Convert("seed", "unknown"); err == nil {
+		t.Errorf("conversion to an unknown category succeeded")
+	}
+}
+
+func TestParseAlmanacErrors(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		line  int
+	}{
+		{"invalid header", "seeds: 1 2\n\nseed to soil:\n1 2 3\n", 3},
+		{"out of order", "seeds: 1 2\n\nsoil-to-location map:\n1 2 3\n\nseed-to-soil map:\n", 3},
+		{"duplicated", "seeds: 1 2\n\nseed-to-soil map:\n\nsoil-to-seed map:\n\nseed-to-location map:\n", 7},
+		{"missing", "seeds: 1 2\n\nseed-to-soil map:\n1 2 3\n", 0},
+		{"mapping without map", "seeds: 1 2\n1 2 3\n", 2},
+	}
+
+	for _, test := range tests {
+		lines := strings.Split(strings.TrimSuffix(test.input, "\n"), "\n")
+		_, err := parseAlmanac(lines)
+
+		var pe *aoc.ParseError
+		if !errors.As(err, &pe) {
+			t.Errorf("%v: got %v, want a ParseError", test.name, err)
+			continue
+		}
+		if pe.Line != test.line {
+			t.Errorf("%v: error %q in line %v, want line %v", test.name, err, pe.Line, test.line)
+		}
+	}
+}
@@ -0,0 +1,77 @@
+// Command almanacdot writes a Graphviz diagram of how the seeds of a day 5
+// almanac are split and shifted by every map, e.g.
+//
+//	almanacdot inputs/day5.txt | dot -Tsvg > almanac.svg
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"os"
+
+	"aoc2023/day5"
+)
+
+const usage = `usage: almanacdot [flags] <input>
+
+<input> is a day 5 almanac or "-" for stdin.
+
+flags:
+`
+
+func readInput(name string) (io.ReadCloser, error) {
+	if name == "-" {
+		return io.NopCloser(os.Stdin), nil
+	}
+
+	return os.Open(name)
+}
+
+func run() error {
+	flags := flag.NewFlagSet("almanacdot", flag.ExitOnError)
+	part := flags.Int("part", 2, "read the seeds as single seeds (1) or as ranges (2)")
+	strict := flags.Bool("strict", false, "refuse almanacs with overlapping maps")
+	flags.Usage = func() {
+		fmt.Fprint(os.Stderr, usage)
+		flags.PrintDefaults()
+	}
+	flags.Parse(os.Args[1:])
+
+	if flags.NArg() != 1 || (*part != 1 && *part != 2) {
+		flags.Usage()
+		os.Exit(2)
+	}
+
+	input, err := readInput(flags.Arg(0))
+	if err != nil {
+		return err
+	}
+	defer input.Close()
+
+	almanac, err := day5.ParseAlmanac(input, day5.ParseOptions{Strict: *strict})
+	if err != nil {
+		return err
+	}
+
+	var seeds []day5.Range
+	if *part == 1 {
+		for _, seed := range almanac.Seeds {
+			seeds = append(seeds, day5.Range{Start: seed, End: seed + 1})
+		}
+	} else {
+		seeds, err = almanac.SeedRanges()
+		if err != nil {
+			return err
+		}
+	}
+
+	return almanac.WriteDot(os.Stdout, seeds)
+}
+
+func main() {
+	if err := run(); err != nil {
+		fmt.Fprintf(os.Stderr, "almanacdot: %v\n", err)
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,91 @@
+// Command bruteforce checks the lowest location of part 2 of a day 5 almanac
+// by mapping every single seed and compares it with the range based solution,
+// e.g.
+//
+//	bruteforce inputs/day5.txt
+//
+// This takes minutes for real inputs.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"os"
+
+	"aoc2023/day5"
+)
+
+const usage = `usage: bruteforce [flags] <input>
+
+<input> is a day 5 almanac or "-" for stdin.
+
+flags:
+`
+
+func readInput(name string) (io.ReadCloser, error) {
+	if name == "-" {
+		return io.NopCloser(os.Stdin), nil
+	}
+
+	return os.Open(name)
+}
+
+// crossCheck compares the lowest location of the seed ranges with the brute
+// force solution.
+func crossCheck(almanac day5.Almanac) error {
+	seeds, err := almanac.SeedRanges()
+	if err != nil {
+		return err
+	}
+
+	want, ok, err := almanac.BruteForceLowestLocation()
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return fmt.Errorf("there are no seeds")
+	}
+
+	got := almanac.LocationRanges(seeds)[0].Start
+	fmt.Printf("lowest location %v, brute force %v\n", got, want)
+	if got != want {
+		return fmt.Errorf("the range based solution differs from the brute force")
+	}
+	return nil
+}
+
+func run() error {
+	flags := flag.NewFlagSet("bruteforce", flag.ExitOnError)
+	strict := flags.Bool("strict", false, "refuse almanacs with overlapping maps")
+	flags.Usage = func() {
+		fmt.Fprint(os.Stderr, usage)
+		flags.PrintDefaults()
+	}
+	flags.Parse(os.Args[1:])
+
+	if flags.NArg() != 1 {
+		flags.Usage()
+		os.Exit(2)
+	}
+
+	input, err := readInput(flags.Arg(0))
+	if err != nil {
+		return err
+	}
+	defer input.Close()
+
+	almanac, err := day5.ParseAlmanac(input, day5.ParseOptions{Strict: *strict})
+	if err != nil {
+		return err
+	}
+
+	return crossCheck(almanac)
+}
+
+func main() {
+	if err := run(); err != nil {
+		fmt.Fprintf(os.Stderr, "bruteforce: %v\n", err)
+		os.Exit(1)
+	}
+}
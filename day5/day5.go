@@ -0,0 +1,200 @@
+package day5
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"aoc2023/aoc"
+)
+
+type Mapping struct {
+	SrcStart int
+	DstStart int
+	Length   int
+
+	// Line is the line of the mapping in the almanac, 0 if it is not known.
+	Line int
+}
+
+func ParseMapping(line string) (Mapping, error) {
+	numbers, err := aoc.ParseInts(line)
+	if err != nil {
+		return Mapping{}, err
+	}
+
+	if len(numbers) != 3 {
+		return Mapping{}, aoc.Errorf(1, "expected \"<destination> <source> <length>\"")
+	}
+
+	return Mapping{
+		SrcStart: numbers[1],
+		DstStart: numbers[0],
+		Length:   numbers[2],
+	}, nil
+}
+
+func (m Mapping) Contains(srcIndex int) bool {
+	return m.SrcStart <= srcIndex && srcIndex < (m.SrcStart+m.Length)
+}
+
+func (m Mapping) Map(srcIndex int) int {
+	return srcIndex - m.SrcStart + m.DstStart
+}
+
+type Mappings []Mapping
+
+// Find returns the mapping that is used for index.
+func (m Mappings) Find(index int) (Mapping, bool) {
+	for _, mapping := range m {
+		if mapping.Contains(index) {
+			return mapping, true
+		}
+	}
+	return Mapping{}, false
+}
+
+func (m Mappings) Map(index int) int {
+	if mapping, ok := m.Find(index); ok {
+		return mapping.Map(index)
+	}
+	return index
+}
+
+func parseSeeds(line string) ([]int, error) {
+	const label = "seeds:"
+
+	if !strings.HasPrefix(line, label) {
+		return nil, aoc.Errorf(1, "expected %q", label)
+	}
+
+	seeds, err := aoc.ParseInts(line[len(label):])
+	if err != nil {
+		return nil, aoc.WithOffset(len(label), err)
+	}
+	if len(seeds) == 0 {
+		return nil, aoc.Errorf(len(label)+1, "no seeds")
+	}
+
+	return seeds, nil
+}
+
+// Almanac is the parsed puzzle input. The layers are applied in order to get
+// from a seed to its location.
+type Almanac struct {
+	Seeds  []int
+	Layers []Layer
+}
+
+func parseAlmanac(lines []string) (Almanac, error) {
+	if len(lines) == 0 {
+		return Almanac{}, aoc.Errorf(0, "empty almanac")
+	}
+
+	seeds, err := parseSeeds(lines[0])
+	if err != nil {
+		return Almanac{}, aoc.AtLine(1, lines[0], err)
+	}
+
+	result := Almanac{Seeds: seeds}
+	isHeader := false
+	for i, line := range lines[1:] {
+		lineNo := i + 2
+
+		if len(line) == 0 {
+			// delimiter for new mapping
+			isHeader = true
+			continue
+		}
+
+		if isHeader {
+			isHeader = false
+
+			layer, err := parseHeader(line)
+			if err != nil {
+				return Almanac{}, aoc.AtLine(lineNo, line, err)
+			}
+
+			layer.Line = lineNo
+			result.Layers = append(result.Layers, layer)
+			continue
+		}
+
+		if len(result.Layers) == 0 {
+			return Almanac{}, aoc.AtLine(lineNo, line, aoc.Errorf(1, "mapping outside of a map"))
+		}
+
+		mapping, err := ParseMapping(line)
+		if err != nil {
+			return Almanac{}, aoc.AtLine(lineNo, line, err)
+		}
+
+		mapping.Line = lineNo
+
+		layer := &result.Layers[len(result.Layers)-1]
+		layer.Mappings = append(layer.Mappings, mapping)
+	}
+
+	if err := checkOrder(result.Layers, lines); err != nil {
+		return Almanac{}, err
+	}
+
+	return result, nil
+}
+
+func readAlmanac(r io.Reader) (Almanac, error) {
+	return ParseAlmanac(r, ParseOptions{})
+}
+
+func (a Almanac) Location(seed int) int {
+	for _, layer := range a.Layers {
+		seed = layer.Mappings.Map(seed)
+	}
+	return seed
+}
+
+// Function composes all layers into a single function from seed to location.
+func (a Almanac) Function() Function {
+	return Compose(mappingsOf(a.Layers)...)
+}
+
+// LocationRanges maps the seed ranges through all layers. The result is
+// sorted and merged.
+func (a Almanac) LocationRanges(seeds []Range) []Range {
+	ranges := mergeRanges(seeds)
+	for _, layer := range a.Layers {
+		ranges = layer.Mappings.MapRanges(ranges)
+	}
+	return ranges
+}
+
+// Solve1 returns the lowest location of the listed seeds.
+func Solve1(almanac Almanac) (aoc.Answer, error) {
+	location := almanac.Function()
+
+	lowest := location.Map(almanac.Seeds[0])
+	for _, seed := range almanac.Seeds[1:] {
+		lowest = min(lowest, location.Map(seed))
+	}
+
+	return aoc.Int(lowest), nil
+}
+
+// Solve2 returns the lowest location of the seed ranges.
+func Solve2(almanac Almanac) (aoc.Answer, error) {
+	seeds, err := seedRanges(almanac.Seeds)
+	if err != nil {
+		return "", aoc.AtLine(1, "", err)
+	}
+
+	locations := almanac.LocationRanges(seeds)
+	if len(locations) == 0 {
+		return "", fmt.Errorf("all seed ranges are empty")
+	}
+
+	return aoc.Int(locations[0].Start), nil
+}
+
+func init() {
+	aoc.RegisterParsed(5, readAlmanac, Solve1, Solve2)
+}
@@ -0,0 +1,28 @@
+package day5
+
+import (
+	"embed"
+	"testing"
+
+	"aoc2023/aoc/aoctest"
+)
+
+//go:embed example*
+var exampleFiles embed.FS
+
+var examples = []aoctest.Example{
+	{File: "example1", Part: 1, Want: "35"},
+	{File: "example1", Part: 2, Want: "46"},
+}
+
+func TestExamples(t *testing.T) {
+	aoctest.Run(t, 5, exampleFiles, examples)
+}
+
+func BenchmarkDay5Part1(b *testing.B) {
+	aoctest.Benchmark(b, 5, 1, exampleFiles, examples)
+}
+
+func BenchmarkDay5Part2(b *testing.B) {
+	aoctest.Benchmark(b, 5, 2, exampleFiles, examples)
+}
@@ -0,0 +1,123 @@
+package day5
+
+import (
+	"cmp"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+)
+
+// SeedRanges returns the seeds as ranges like in part 2.
+func (a Almanac) SeedRanges() ([]Range, error) {
+	return seedRanges(a.Seeds)
+}
+
+type dotEdge struct {
+	from, to int
+	offset   int
+	line     int
+}
+
+// WriteDot writes a Graphviz diagram of how the seed ranges are split and
+// shifted by every layer. Each category is a cluster with one node per range,
+// the edges are labelled with the offset and the line of the mapping. Indices
+// that are not mapped are connected by dashed edges. The lowest location is
+// highlighted.
+func (a Almanac) WriteDot(w io.Writer, seeds []Range) error {
+	var b strings.Builder
+
+	b.WriteString("digraph almanac {\n")
+	b.WriteString("\trankdir=LR;\n")
+	b.WriteString("\tnode [shape=box];\n")
+
+	ranges := sortedUnique(seeds)
+	writeCluster(&b, 0, SeedCategory, ranges, -1)
+
+	for i, layer := range a.Layers {
+		ids := make(map[Range]int)
+		edges := make([]dotEdge, 0, len(ranges))
+		next := make([]Range, 0, len(ranges))
+
+		for from, r := range ranges {
+			for _, piece := range layer.Mappings.split(r) {
+				dst := piece.Shift(piece.Offset)
+				to, ok := ids[dst]
+				if !ok {
+					to = len(next)
+					ids[dst] = to
+					next = append(next, dst)
+				}
+
+				line := 0
+				if mapping, ok := layer.Mappings.Find(piece.Start); ok {
+					line = mapping.Line
+				}
+				edges = append(edges, dotEdge{from, to, piece.Offset, line})
+			}
+		}
+
+		// sort the nodes of the next category and renumber the edges
+		order := make([]int, len(next))
+		for j := range order {
+			order[j] = j
+		}
+		slices.SortFunc(order, func(x, y int) int {
+			return compareRanges(next[x], next[y])
+		})
+		position := make([]int, len(next))
+		for j, k := range order {
+			position[k] = j
+		}
+		for j := range edges {
+			edges[j].to = position[edges[j].to]
+		}
+		ranges = sortedUnique(next)
+
+		lowest := -1
+		if i == len(a.Layers)-1 && len(ranges) > 0 {
+			lowest = 0
+		}
+		writeCluster(&b, i+1, layer.Destination, ranges, lowest)
+
+		for _, edge := range edges {
+			fmt.Fprintf(&b, "\tn%v_%v -> n%v_%v", i, edge.from, i+1, edge.to)
+			if edge.line == 0 {
+				b.WriteString(" [style=dashed];\n")
+			} else {
+				fmt.Fprintf(&b, " [label=\"%+d (line %v)\"];\n", edge.offset, edge.line)
+			}
+		}
+	}
+
+	b.WriteString("}\n")
+
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+func compareRanges(a, b Range) int {
+	if c := cmp.Compare(a.Start, b.Start); c != 0 {
+		return c
+	}
+	return cmp.Compare(a.End, b.End)
+}
+
+func sortedUnique(ranges []Range) []Range {
+	result := slices.Clone(ranges)
+	slices.SortFunc(result, compareRanges)
+	return slices.Compact(result)
+}
+
+func writeCluster(b *strings.Builder, index int, category string, ranges []Range, highlight int) {
+	fmt.Fprintf(b, "\tsubgraph cluster_%v {\n", index)
+	fmt.Fprintf(b, "\t\tlabel=%q;\n", category)
+	for i, r := range ranges {
+		fmt.Fprintf(b, "\t\tn%v_%v [label=\"%v\"", index, i, r)
+		if i == highlight {
+			b.WriteString(", style=filled, fillcolor=lightgreen")
+		}
+		b.WriteString("];\n")
+	}
+	b.WriteString("\t}\n")
+}
@@ -0,0 +1,65 @@
+package day5
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestWriteDot(t *testing.T) {
+	const input = "seeds: 0 10\n\nseed-to-location map:\n100 5 10\n"
+
+	almanac, err := ParseAlmanac(strings.NewReader(input), ParseOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	seeds, err := almanac.SeedRanges()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var b strings.Builder
+	if err := almanac.WriteDot(&b, seeds); err != nil {
+		t.Fatal(err)
+	}
+
+	want := `digraph almanac {
+	rankdir=LR;
+	node [shape=box];
+	subgraph cluster_0 {
+		label="seed";
+		n0_0 [label="[0, 10)"];
+	}
+	subgraph cluster_1 {
+		label="location";
+		n1_0 [label="[0, 5)", style=filled, fillcolor=lightgreen];
+		n1_1 [label="[100, 105)"];
+	}
+	n0_0 -> n1_0 [style=dashed];
+	n0_0 -> n1_1 [label="+95 (line 4)"];
+}
+`
+	if got := b.String(); got != want {
+		t.Errorf("WriteDot() =\n%v\nwant\n%v", got, want)
+	}
+}
+
+func TestWriteDotExample(t *testing.T) {
+	almanac := readExample(t)
+	seeds, err := almanac.SeedRanges()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var b strings.Builder
+	if err := almanac.WriteDot(&b, seeds); err != nil {
+		t.Fatal(err)
+	}
+
+	dot := b.String()
+	if got := strings.Count(dot, "subgraph"); got != 8 {
+		t.Errorf("%v clusters, want 8", got)
+	}
+	if !strings.Contains(dot, `[label="[46, 56)", style=filled`) {
+		t.Errorf("lowest location [46, 56) is not highlighted:\n%v", dot)
+	}
+}
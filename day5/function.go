@@ -0,0 +1,148 @@
+package day5
+
+import (
+	"fmt"
+	"math"
+	"slices"
+	"strings"
+)
+
+// Segment maps every index of its range by adding Offset.
+type Segment struct {
+	Range
+	Offset int
+}
+
+// Function is a piecewise linear function over all integers. The segments are
+// sorted, adjacent and cover [math.MinInt, math.MaxInt), so indices that are
+// not mapped by any Mapping are covered by explicit identity segments with an
+// offset of 0.
+type Function []Segment
+
+func Identity() Function {
+	return Function{{Range{math.MinInt, math.MaxInt}, 0}}
+}
+
+// Function converts the layer into a piecewise function. Like in Map the
+// first mapping wins if mappings overlap.
+func (m Mappings) Function() Function {
+	points := []int{math.MinInt, math.MaxInt}
+	for _, mapping := range m {
+		src := mapping.Source()
+		if !src.Empty() {
+			points = append(points, src.Start, src.End)
+		}
+	}
+	slices.Sort(points)
+	points = slices.Compact(points)
+
+	result := make(Function, 0, len(points)-1)
+	for i := 0; i+1 < len(points); i++ {
+		start := points[i]
+		result = append(result, Segment{Range{start, points[i+1]}, m.Map(start) - start})
+	}
+
+	return result.merge()
+}
+
+// merge joins adjacent segments with the same offset.
+func (f Function) merge() Function {
+	result := make(Function, 0, len(f))
+	for _, seg := range f {
+		if seg.Empty() {
+			continue
+		}
+
+		if len(result) > 0 {
+			last := &result[len(result)-1]
+			if last.End == seg.Start && last.Offset == seg.Offset {
+				last.End = seg.End
+				continue
+			}
+		}
+		result = append(result, seg)
+	}
+	return result
+}
+
+// find returns the index of the segment containing index.
+func (f Function) find(index int) int {
+	i, _ := slices.BinarySearchFunc(f, index, func(seg Segment, index int) int {
+		if seg.End <= index {
+			return -1
+		}
+		if seg.Start > index {
+			return 1
+		}
+		return 0
+	})
+	return i
+}
+
+func (f Function) Map(index int) int {
+	return index + f[f.find(index)].Offset
+}
+
+// MapRanges maps all ranges and merges the result. The result is sorted.
+func (f Function) MapRanges(ranges []Range) []Range {
+	result := make([]Range, 0, len(ranges))
+	for _, r := range ranges {
+		for i := f.find(r.Start); i < len(f) && f[i].Start < r.End; i++ {
+			piece := f[i].Intersect(r)
+			result = append(result, piece.Shift(f[i].Offset))
+		}
+	}
+	return mergeRanges(result)
+}
+
+// Then returns the function that first applies f and then g.
+func (f Function) Then(g Function) Function {
+	result := make(Function, 0, len(f)+len(g))
+
+	for _, seg := range f {
+		// identity segments at the border of the domain must not overflow
+		image := seg.Range
+		if seg.Offset != 0 {
+			image = seg.Shift(seg.Offset)
+		}
+
+		for i := g.find(image.Start); i < len(g) && g[i].Start < image.End; i++ {
+			piece := g[i].Intersect(image)
+			if seg.Offset != 0 {
+				piece = piece.Shift(-seg.Offset)
+			}
+
+			result = append(result, Segment{piece, seg.Offset + g[i].Offset})
+		}
+	}
+
+	return result.merge()
+}
+
+// Compose returns a single function that applies all layers in order.
+func Compose(layers ...Mappings) Function {
+	result := Identity()
+	for _, layer := range layers {
+		result = result.Then(layer.Function())
+	}
+	return result
+}
+
+func formatBound(n int) string {
+	switch n {
+	case math.MinInt:
+		return "-inf"
+	case math.MaxInt:
+		return "inf"
+	}
+	return fmt.Sprint(n)
+}
+
+// String prints one segment per line with its source range and offset.
+func (f Function) String() string {
+	var sb strings.Builder
+	for _, seg := range f {
+		fmt.Fprintf(&sb, "[%v, %v) %+d\n", formatBound(seg.Start), formatBound(seg.End), seg.Offset)
+	}
+	return sb.String()
+}
@@ -0,0 +1,80 @@
+package day5
+
+import (
+	"math"
+	"math/rand"
+	"slices"
+	"testing"
+)
+
+func checkCoverage(t *testing.T, f Function) {
+	t.Helper()
+
+	if len(f) == 0 || f[0].Start != math.MinInt || f[len(f)-1].End != math.MaxInt {
+		t.Fatalf("function does not cover all integers:\n%v", f)
+	}
+	for i := 1; i < len(f); i++ {
+		if f[i-1].End != f[i].Start {
+			t.Fatalf("segments %v and %v are not adjacent", f[i-1], f[i])
+		}
+		if f[i-1].Offset == f[i].Offset {
+			t.Fatalf("segments %v and %v are not merged", f[i-1], f[i])
+		}
+	}
+}
+
+func TestMappingsFunction(t *testing.T) {
+	rng := rand.New(rand.NewSource(12))
+
+	for i := 0; i < 200; i++ {
+		m := randomMappings(rng, rng.Intn(6), 100)
+		f := m.Function()
+		checkCoverage(t, f)
+
+		for index := -10; index < 110; index++ {
+			if got, want := f.Map(index), m.Map(index); got != want {
+				t.Fatalf("%v: Map(%v) = %v, want %v", m, index, got, want)
+			}
+		}
+	}
+}
+
+func TestCompose(t *testing.T) {
+	rng := rand.New(rand.NewSource(13))
+
+	for i := 0; i < 100; i++ {
+		layers := make([]Mappings, rng.Intn(5))
+		for j := range layers {
+			layers[j] = randomMappings(rng, rng.Intn(5), 100)
+		}
+		almanac := Almanac{}
+		for _, layer := range layers {
+			almanac.Layers = append(almanac.Layers, Layer{Mappings: layer})
+		}
+
+		f := Compose(layers...)
+		checkCoverage(t, f)
+
+		for index := -10; index < 110; index++ {
+			if got, want := f.Map(index), almanac.Location(index); got != want {
+				t.Fatalf("Map(%v) = %v, want %v", index, got, want)
+			}
+		}
+
+		ranges := []Range{{rng.Intn(100), 100}, {-5, rng.Intn(50)}}
+		got := f.MapRanges(ranges)
+		want := almanac.LocationRanges(ranges)
+		if !slices.Equal(got, want) {
+			t.Fatalf("MapRanges(%v) = %v, want %v", ranges, got, want)
+		}
+	}
+}
+
+func TestFunctionString(t *testing.T) {
+	almanac := readExample(t)
+
+	want := "[-inf, 50) +0\n[50, 98) +2\n[98, 100) -48\n[100, inf) +0\n"
+	if got := almanac.Layers[0].Mappings.Function().String(); got != want {
+		t.Errorf("got\n%v\nwant\n%v", got, want)
+	}
+}
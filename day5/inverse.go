@@ -0,0 +1,151 @@
+package day5
+
+import "slices"
+
+func (m Mapping) Destination() Range {
+	return Range{m.DstStart, m.DstStart + m.Length}
+}
+
+// Inverse returns the mapping from the destination back to the source.
+func (m Mapping) Inverse() Mapping {
+	return Mapping{
+		SrcStart: m.DstStart,
+		DstStart: m.SrcStart,
+		Length:   m.Length,
+		Line:     m.Line,
+	}
+}
+
+// Inverse returns the layer that maps every destination back to its source.
+// This is only possible if the layer is a bijection, i.e. neither the sources
+// nor the destinations overlap and both cover the same indices. Otherwise
+// ok is false and Function.Preimage has to be used instead.
+func (m Mappings) Inverse() (Mappings, bool) {
+	sources := make([]Range, 0, len(m))
+	destinations := make([]Range, 0, len(m))
+	length := 0
+	for _, mapping := range m {
+		sources = append(sources, mapping.Source())
+		destinations = append(destinations, mapping.Destination())
+		length += max(mapping.Length, 0)
+	}
+
+	sources = mergeRanges(sources)
+	destinations = mergeRanges(destinations)
+
+	covered := 0
+	for _, r := range sources {
+		covered += r.Len()
+	}
+	if covered != length || !slices.Equal(sources, destinations) {
+		return nil, false
+	}
+
+	result := make(Mappings, len(m))
+	for i, mapping := range m {
+		result[i] = mapping.Inverse()
+	}
+	return result, true
+}
+
+// Inverse returns the almanac that maps locations back to seeds. See
+// Mappings.Inverse for when this is possible.
+func (a Almanac) Inverse() (Almanac, bool) {
+	result := Almanac{Layers: make([]Layer, len(a.Layers))}
+
+	for i, layer := range a.Layers {
+		inverse, ok := layer.Mappings.Inverse()
+		if !ok {
+			return Almanac{}, false
+		}
+
+		result.Layers[len(a.Layers)-1-i] = Layer{
+			Source:      layer.Destination,
+			Destination: layer.Source,
+			Mappings:    inverse,
+			Line:        layer.Line,
+		}
+	}
+	return result, true
+}
+
+// Preimage returns all indices that are mapped into r. Unlike an inverse it
+// also works for functions that map several indices to the same one.
+func (f Function) Preimage(r Range) []Range {
+	result := make([]Range, 0)
+	for _, seg := range f {
+		// identity segments at the border of the domain must not overflow
+		if seg.Offset == 0 {
+			result = append(result, seg.Intersect(r))
+			continue
+		}
+
+		piece := seg.Shift(seg.Offset).Intersect(r)
+		if !piece.Empty() {
+			result = append(result, piece.Shift(-seg.Offset))
+		}
+	}
+	return mergeRanges(result)
+}
+
+// SeedsFor returns all seeds that lead to location, regardless of whether
+// they are listed in the almanac.
+func (a Almanac) SeedsFor(location int) []Range {
+	return a.Function().Preimage(Range{location, location + 1})
+}
+
+// Lowest returns the lowest location that is reachable from the seed ranges,
+// the lowest seed leading to it and the seed range this seed belongs to. ok
+// is false if all seed ranges are empty.
+func (a Almanac) Lowest(seeds []Range) (location, seed int, origin Range, ok bool) {
+	f := a.Function()
+
+	locations := f.MapRanges(seeds)
+	if len(locations) == 0 {
+		return 0, 0, Range{}, false
+	}
+	location = locations[0].Start
+
+	for _, candidates := range f.Preimage(Range{location, location + 1}) {
+		for _, r := range seeds {
+			found := r.Intersect(candidates)
+			if found.Empty() {
+				continue
+			}
+
+			if !ok || found.Start < seed {
+				seed = found.Start
+				origin = r
+				ok = true
+			}
+		}
+	}
+
+	return location, seed, origin, ok
+}
+
+// Step is the value after applying one layer of the almanac. If Mapped is
+// true, Mapping is the mapping responsible for the value. Otherwise no mapping
+// of the layer contained the previous value and it was kept as it is.
+type Step struct {
+	Value   int
+	Mapping Mapping
+	Mapped  bool
+}
+
+// Trace returns the steps from seed to its location, one per layer.
+func (a Almanac) Trace(seed int) []Step {
+	result := make([]Step, 0, len(a.Layers))
+
+	value := seed
+	for _, layer := range a.Layers {
+		mapping, ok := layer.Mappings.Find(value)
+		if ok {
+			value = mapping.Map(value)
+		}
+
+		result = append(result, Step{value, mapping, ok})
+	}
+
+	return result
+}
@@ -0,0 +1,106 @@
+package day5
+
+import (
+	"bytes"
+	"slices"
+	"testing"
+)
+
+func readExample(t *testing.T) Almanac {
+	t.Helper()
+
+	input, err := exampleFiles.ReadFile("example1")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	almanac, err := readAlmanac(bytes.NewReader(input))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return almanac
+}
+
+func TestInverse(t *testing.T) {
+	almanac := readExample(t)
+
+	inverse, ok := almanac.Inverse()
+	if !ok {
+		t.Fatalf("the example almanac is not invertible")
+	}
+
+	for seed := -5; seed < 120; seed++ {
+		location := almanac.Location(seed)
+		if got := inverse.Location(location); got != seed {
+			t.Errorf("seed %v -> location %v -> seed %v", seed, location, got)
+		}
+	}
+
+	if _, ok := (Mappings{{SrcStart: 0, DstStart: 10, Length: 5}}).Inverse(); ok {
+		t.Errorf("mapping to a different range is invertible")
+	}
+	if _, ok := (Mappings{{SrcStart: 0, DstStart: 5, Length: 10}, {SrcStart: 5, DstStart: 0, Length: 10}}).Inverse(); ok {
+		t.Errorf("overlapping mappings are invertible")
+	}
+}
+
+func TestPreimage(t *testing.T) {
+	// 10..14 and 20..24 are both mapped to 20..24
+	f := (Mappings{{SrcStart: 10, DstStart: 20, Length: 5}}).Function()
+
+	got := f.Preimage(Range{20, 25})
+	want := []Range{{10, 15}, {20, 25}}
+	if !slices.Equal(got, want) {
+		t.Errorf("Preimage = %v, want %v", got, want)
+	}
+
+	got = f.Preimage(Range{10, 15})
+	if len(got) != 0 {
+		t.Errorf("Preimage of the unreachable range = %v, want none", got)
+	}
+}
+
+func TestLowest(t *testing.T) {
+	almanac := readExample(t)
+
+	seeds, err := seedRanges(almanac.Seeds)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	location, seed, origin, ok := almanac.Lowest(seeds)
+	if !ok || location != 46 || seed != 82 || origin != (Range{79, 93}) {
+		t.Errorf("Lowest = %v, %v, %v, %v, want 46, 82, [79, 93), true", location, seed, origin, ok)
+	}
+
+	found := false
+	for _, r := range almanac.SeedsFor(46) {
+		found = found || r.Contains(82)
+	}
+	if !found {
+		t.Errorf("SeedsFor(46) = %v does not contain seed 82", almanac.SeedsFor(46))
+	}
+}
+
+func TestTrace(t *testing.T) {
+	almanac := readExample(t)
+
+	steps := almanac.Trace(79)
+
+	values := make([]int, len(steps))
+	for i, step := range steps {
+		values[i] = step.Value
+	}
+	if want := []int{81, 81, 81, 74, 78, 78, 82}; !slices.Equal(values, want) {
+		t.Errorf("Trace(79) = %v, want %v", values, want)
+	}
+
+	// seed-to-soil uses "52 50 48" from line 5
+	if !steps[0].Mapped || steps[0].Mapping.Line != 5 {
+		t.Errorf("first step is mapped by %+v, want the mapping in line 5", steps[0])
+	}
+	// soil-to-fertilizer does not contain 81
+	if steps[1].Mapped {
+		t.Errorf("second step is mapped by %+v, want no mapping", steps[1])
+	}
+}
@@ -0,0 +1,143 @@
+package day5
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+)
+
+// Range is the half-open interval [Start, End).
+type Range struct {
+	Start int
+	End   int
+}
+
+func (r Range) Len() int {
+	return r.End - r.Start
+}
+
+func (r Range) Empty() bool {
+	return r.End <= r.Start
+}
+
+func (r Range) Contains(index int) bool {
+	return r.Start <= index && index < r.End
+}
+
+// Intersect returns the common part of r and o, which might be empty.
+func (r Range) Intersect(o Range) Range {
+	return Range{max(r.Start, o.Start), min(r.End, o.End)}
+}
+
+func (r Range) Shift(offset int) Range {
+	return Range{r.Start + offset, r.End + offset}
+}
+
+func (r Range) String() string {
+	return fmt.Sprintf("[%v, %v)", r.Start, r.End)
+}
+
+// mergeRanges sorts the ranges and joins overlapping and adjacent ranges.
+// Empty ranges are dropped.
+func mergeRanges(ranges []Range) []Range {
+	sorted := make([]Range, 0, len(ranges))
+	for _, r := range ranges {
+		if !r.Empty() {
+			sorted = append(sorted, r)
+		}
+	}
+	slices.SortFunc(sorted, func(a, b Range) int {
+		return cmp.Compare(a.Start, b.Start)
+	})
+
+	result := make([]Range, 0, len(sorted))
+	for _, r := range sorted {
+		if len(result) > 0 && r.Start <= result[len(result)-1].End {
+			last := &result[len(result)-1]
+			last.End = max(last.End, r.End)
+			continue
+		}
+		result = append(result, r)
+	}
+
+	return result
+}
+
+func seedRanges(seedPairs []int) ([]Range, error) {
+	if len(seedPairs)%2 != 0 {
+		return nil, fmt.Errorf("number of seeds has to be even")
+	}
+
+	result := make([]Range, 0, len(seedPairs)/2)
+	for i := 0; i < len(seedPairs); i += 2 {
+		start := seedPairs[i]
+		length := seedPairs[i+1]
+
+		result = append(result, Range{start, start + length})
+	}
+
+	return result, nil
+}
+
+func (m Mapping) Source() Range {
+	return Range{m.SrcStart, m.SrcStart + m.Length}
+}
+
+func (m Mapping) Offset() int {
+	return m.DstStart - m.SrcStart
+}
+
+// split splits r at the boundaries of the mappings, so that each piece is
+// either fully inside or fully outside of every mapping and thus is shifted by
+// a single offset.
+func (m Mappings) split(r Range) []Segment {
+	if r.Empty() {
+		return nil
+	}
+
+	points := []int{r.Start}
+	for _, mapping := range m {
+		src := mapping.Source()
+		for _, p := range []int{src.Start, src.End} {
+			if r.Start < p && p < r.End {
+				points = append(points, p)
+			}
+		}
+	}
+	slices.Sort(points)
+	points = slices.Compact(points)
+
+	result := make([]Segment, 0, len(points))
+	for i, start := range points {
+		end := r.End
+		if i+1 < len(points) {
+			end = points[i+1]
+		}
+
+		result = append(result, Segment{Range{start, end}, m.Map(start) - start})
+	}
+
+	return result
+}
+
+// MapRange maps every index in r like Map does. The result is not merged.
+func (m Mappings) MapRange(r Range) []Range {
+	pieces := m.split(r)
+
+	result := make([]Range, 0, len(pieces))
+	for _, piece := range pieces {
+		result = append(result, piece.Shift(piece.Offset))
+	}
+
+	return result
+}
+
+// MapRanges maps all ranges and merges the result. The result is sorted.
+func (m Mappings) MapRanges(ranges []Range) []Range {
+	result := make([]Range, 0, len(ranges))
+	for _, r := range ranges {
+		result = append(result, m.MapRange(r)...)
+	}
+
+	return mergeRanges(result)
+}
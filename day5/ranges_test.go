@@ -0,0 +1,107 @@
+package day5
+
+import (
+	"math/rand"
+	"slices"
+	"testing"
+)
+
+func TestMergeRanges(t *testing.T) {
+	tests := []struct {
+		ranges []Range
+		want   []Range
+	}{
+		{nil, []Range{}},
+		{[]Range{{5, 5}, {7, 3}}, []Range{}},
+		{[]Range{{10, 20}, {0, 5}}, []Range{{0, 5}, {10, 20}}},
+		{[]Range{{0, 5}, {5, 10}}, []Range{{0, 10}}},
+		{[]Range{{0, 8}, {3, 5}, {7, 12}, {20, 21}}, []Range{{0, 12}, {20, 21}}},
+	}
+
+	for _, test := range tests {
+		got := mergeRanges(test.ranges)
+		if !slices.Equal(got, test.want) {
+			t.Errorf("mergeRanges(%v) = %v, want %v", test.ranges, got, test.want)
+		}
+	}
+}
+
+func randomMappings(rng *rand.Rand, n, size int) Mappings {
+	result := make(Mappings, n)
+	for i := range result {
+		result[i] = Mapping{
+			SrcStart: rng.Intn(size),
+			DstStart: rng.Intn(size),
+			Length:   rng.Intn(size / 4),
+		}
+	}
+	return result
+}
+
+// mapEach maps every index of the ranges on its own.
+func mapEach(m Mappings, ranges []Range) []Range {
+	result := make([]Range, 0)
+	for _, r := range ranges {
+		for i := r.Start; i < r.End; i++ {
+			j := m.Map(i)
+			result = append(result, Range{j, j + 1})
+		}
+	}
+	return mergeRanges(result)
+}
+
+func TestMapRangesMatchesMap(t *testing.T) {
+	rng := rand.New(rand.NewSource(5))
+
+	for i := 0; i < 200; i++ {
+		// overlapping mappings are included on purpose, the first one wins
+		m := randomMappings(rng, rng.Intn(6), 100)
+
+		ranges := make([]Range, rng.Intn(4)+1)
+		for j := range ranges {
+			start := rng.Intn(120) - 10
+			ranges[j] = Range{start, start + rng.Intn(40)}
+		}
+
+		got := m.MapRanges(ranges)
+		want := mapEach(m, ranges)
+		if !slices.Equal(got, want) {
+			t.Fatalf("%v.MapRanges(%v) = %v, want %v", m, ranges, got, want)
+		}
+	}
+}
+
+func TestLocationRangesMatchesBruteForce(t *testing.T) {
+	almanac := readExample(t)
+
+	rng := rand.New(rand.NewSource(7))
+	for i := 0; i < 50; i++ {
+		seeds := make([]int, 2*(rng.Intn(4)+1))
+		for j := 0; j < len(seeds); j += 2 {
+			seeds[j] = rng.Intn(100)
+			seeds[j+1] = rng.Intn(30)
+		}
+		almanac.Seeds = seeds
+
+		ranges, err := seedRanges(seeds)
+		if err != nil {
+			t.Fatal(err)
+		}
+		locations := almanac.LocationRanges(ranges)
+
+		if len(locations) == 0 {
+			continue
+		}
+
+		want, ok, err := almanac.BruteForceLowestLocation()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !ok {
+			t.Fatalf("seeds %v: brute force found no location", seeds)
+		}
+		if locations[0].Start != want {
+			t.Fatalf("seeds %v: lowest location %v, brute force %v", seeds, locations[0].Start, want)
+		}
+	}
+}
@@ -0,0 +1,135 @@
+package day5
+
+import (
+	"cmp"
+	"fmt"
+	"io"
+	"slices"
+
+	"aoc2023/aoc"
+)
+
+type IssueKind int
+
+const (
+	// Overlap of source ranges, only the first mapping is ever used for the
+	// common part.
+	Overlap IssueKind = iota + 1
+	// Gap between source ranges, the indices in it are kept as they are.
+	Gap
+	// ZeroLength mapping, that never maps anything.
+	ZeroLength
+)
+
+func (k IssueKind) String() string {
+	switch k {
+	case Overlap:
+		return "overlap"
+	case Gap:
+		return "gap"
+	case ZeroLength:
+		return "zero-length range"
+	}
+	return "?"
+}
+
+// Issue is a finding of Validate. Lines are the lines of the mappings
+// involved and Range is the affected source range.
+type Issue struct {
+	Kind  IssueKind
+	Layer string
+	Lines []int
+	Range Range
+}
+
+func (i Issue) String() string {
+	return fmt.Sprintf("%v: %v %v (lines %v)", i.Layer, i.Kind, i.Range, i.Lines)
+}
+
+// Validate reports overlapping source ranges, gaps between source ranges and
+// mappings with a length of zero or less. Overlaps make the result depend on
+// the order of the mappings, gaps and zero lengths are only suspicious.
+func (l Layer) Validate() []Issue {
+	result := make([]Issue, 0)
+
+	for i, a := range l.Mappings {
+		if a.Length <= 0 {
+			result = append(result, Issue{ZeroLength, l.String(), []int{a.Line}, a.Source()})
+			continue
+		}
+
+		for _, b := range l.Mappings[i+1:] {
+			common := a.Source().Intersect(b.Source())
+			if !common.Empty() {
+				result = append(result, Issue{Overlap, l.String(), []int{a.Line, b.Line}, common})
+			}
+		}
+	}
+
+	sorted := slices.Clone(l.Mappings)
+	slices.SortFunc(sorted, func(a, b Mapping) int {
+		return cmp.Compare(a.SrcStart, b.SrcStart)
+	})
+
+	var prev *Mapping
+	for i := range sorted {
+		m := &sorted[i]
+		if m.Length <= 0 {
+			continue
+		}
+
+		if prev != nil && prev.Source().End < m.SrcStart {
+			gap := Range{prev.Source().End, m.SrcStart}
+			result = append(result, Issue{Gap, l.String(), []int{prev.Line, m.Line}, gap})
+		}
+
+		if prev == nil || m.Source().End > prev.Source().End {
+			prev = m
+		}
+	}
+
+	return result
+}
+
+// Validate returns the issues of all layers.
+func (a Almanac) Validate() []Issue {
+	result := make([]Issue, 0)
+	for _, layer := range a.Layers {
+		result = append(result, layer.Validate()...)
+	}
+	return result
+}
+
+type ParseOptions struct {
+	// Strict refuses almanacs with overlapping source ranges, because their
+	// result depends on the order of the mappings.
+	Strict bool
+}
+
+// ParseAlmanac reads an almanac. In strict mode the first overlap is reported
+// as a ParseError at the line of the later mapping.
+func ParseAlmanac(r io.Reader, opts ParseOptions) (Almanac, error) {
+	lines, err := aoc.ReadLines(r)
+	if err != nil {
+		return Almanac{}, err
+	}
+
+	almanac, err := parseAlmanac(lines)
+	if err != nil {
+		return Almanac{}, err
+	}
+
+	if opts.Strict {
+		for _, issue := range almanac.Validate() {
+			if issue.Kind != Overlap {
+				continue
+			}
+
+			line := issue.Lines[1]
+			err := aoc.Errorf(1, "%v overlaps with the mapping in line %v", issue.Range, issue.Lines[0])
+			return Almanac{}, aoc.AtLine(line, lines[line-1], err)
+		}
+	}
+
+	return almanac, nil
+}
@@ -0,0 +1,63 @@
+package day5
+
+import (
+	"errors"
+	"slices"
+	"strings"
+	"testing"
+
+	"aoc2023/aoc"
+)
+
+func TestValidate(t *testing.T) {
+	layer := Layer{
+		Source:      "seed",
+		Destination: "soil",
+		Mappings: Mappings{
+			{SrcStart: 0, DstStart: 100, Length: 10, Line: 4},
+			{SrcStart: 5, DstStart: 200, Length: 10, Line: 5},
+			{SrcStart: 20, DstStart: 300, Length: 5, Line: 6},
+			{SrcStart: 30, DstStart: 400, Length: 0, Line: 7},
+		},
+	}
+
+	want := []Issue{
+		{Overlap, "seed-to-soil", []int{4, 5}, Range{5, 10}},
+		{ZeroLength, "seed-to-soil", []int{7}, Range{30, 30}},
+		{Gap, "seed-to-soil", []int{5, 6}, Range{15, 20}},
+	}
+
+	got := layer.Validate()
+	if !slices.EqualFunc(got, want, func(a, b Issue) bool {
+		return a.Kind == b.Kind && a.Layer == b.Layer && a.Range == b.Range && slices.Equal(a.Lines, b.Lines)
+	}) {
+		t.Errorf("Validate() = %v, want %v", got, want)
+	}
+}
+
+func TestValidateExample(t *testing.T) {
+	almanac := readExample(t)
+
+	for _, issue := range almanac.Validate() {
+		if issue.Kind == Overlap {
+			t.Errorf("unexpected issue in the example: %v", issue)
+		}
+	}
+}
+
+func TestStrict(t *testing.T) {
+	const input = "seeds: 1 2\n\nseed-to-location map:\n10 0 5\n20 3 5\n"
+
+	if _, err := ParseAlmanac(strings.NewReader(input), ParseOptions{}); err != nil {
+		t.Fatalf("lenient mode: %v", err)
+	}
+
+	_, err := ParseAlmanac(strings.NewReader(input), ParseOptions{Strict: true})
+	var pe *aoc.ParseError
+	if !errors.As(err, &pe) {
+		t.Fatalf("strict mode: got %v, want a ParseError", err)
+	}
+	if pe.Line != 5 {
+		t.Errorf("error in line %v, want 5", pe.Line)
+	}
+}
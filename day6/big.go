@@ -0,0 +1,63 @@
+package day6
+
+import "math/big"
+
+// countWins returns the number of press times that beat the record. Races that
+// fit into an int are solved with countLinearWins, all others with
+// tryRaceVariantsBig.
+func countWins(totalTime, recordDistance *big.Int) *big.Int {
+	if totalTime.IsInt64() && totalTime.Int64() <= maxTime && recordDistance.IsInt64() {
+		count := countLinearWins(int(totalTime.Int64()), int(recordDistance.Int64()))
+		return big.NewInt(int64(count))
+	}
+
+	return tryRaceVariantsBig(totalTime, recordDistance)
+}
+
+// tryRaceVariantsBig is countLinearWins for arbitrarily large races.
+func tryRaceVariantsBig(totalTime, recordDistance *big.Int) *big.Int {
+	zero := big.NewInt(0)
+	one := big.NewInt(1)
+
+	if totalTime.Sign() <= 0 {
+		return zero
+	}
+	if recordDistance.Sign() < 0 {
+		return new(big.Int).Set(totalTime)
+	}
+
+	// disc = T² - 4D
+	disc := new(big.Int).Mul(totalTime, totalTime)
+	disc.Sub(disc, new(big.Int).Lsh(recordDistance, 2))
+	if disc.Sign() <= 0 {
+		return zero
+	}
+
+	half := new(big.Int).Rsh(totalTime, 1)
+	distance := new(big.Int)
+	wins := func(pressTime *big.Int) bool {
+		distance.Sub(totalTime, pressTime)
+		distance.Mul(distance, pressTime)
+		return distance.Cmp(recordDistance) > 0
+	}
+
+	lowest := new(big.Int).Sqrt(disc)
+	lowest.Sub(totalTime, lowest)
+	lowest.Rsh(lowest, 1)
+
+	for lowest.Cmp(half) <= 0 && !wins(lowest) {
+		lowest.Add(lowest, one)
+	}
+	prev := new(big.Int)
+	for lowest.Sign() > 0 && wins(prev.Sub(lowest, one)) {
+		lowest.Set(prev)
+	}
+	if lowest.Cmp(half) > 0 {
+		return zero
+	}
+
+	// T - 2*lowest + 1
+	count := new(big.Int).Lsh(lowest, 1)
+	count.Sub(totalTime, count)
+	return count.Add(count, one)
+}
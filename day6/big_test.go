@@ -0,0 +1,46 @@
+package day6
+
+import (
+	"math/big"
+	"testing"
+)
+
+func TestTryRaceVariantsBig(t *testing.T) {
+	for totalTime := -2; totalTime < 80; totalTime++ {
+		for recordDistance := -3; recordDistance < totalTime*totalTime/4+3; recordDistance++ {
+			got := tryRaceVariantsBig(big.NewInt(int64(totalTime)), big.NewInt(int64(recordDistance)))
+			want := countLinearWins(totalTime, recordDistance)
+			if !got.IsInt64() || got.Int64() != int64(want) {
+				t.Errorf("tryRaceVariantsBig(%v, %v) = %v, want %v", totalTime, recordDistance, got, want)
+			}
+		}
+	}
+}
+
+func TestCountWins(t *testing.T) {
+	parse := func(s string) *big.Int {
+		n, ok := new(big.Int).SetString(s, 10)
+		if !ok {
+			t.Fatalf("invalid number %q", s)
+		}
+		return n
+	}
+
+	tests := []struct {
+		totalTime, recordDistance, want string
+	}{
+		{"71530", "940200", "71503"},
+		{"30", "1000000000000000000000000", "0"},
+		// (10^20)² is the best distance
+		{"200000000000000000000", "10000000000000000000000000000000000000000", "0"},
+		{"200000000000000000000", "9999999999999999999999999999999999999999", "1"},
+		{"200000000000000000000", "0", "199999999999999999999"},
+	}
+
+	for _, test := range tests {
+		got := countWins(parse(test.totalTime), parse(test.recordDistance))
+		if got.String() != test.want {
+			t.Errorf("countWins(%v, %v) = %v, want %v", test.totalTime, test.recordDistance, got, test.want)
+		}
+	}
+}
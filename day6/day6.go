@@ -0,0 +1,33 @@
+package day6
+
+import "aoc2023/aoc"
+
+// Solve1 returns the product of the number of ways to beat the record of every
+// race.
+func Solve1(sheet Sheet) (aoc.Answer, error) {
+	races, err := parseRaces(sheet)
+	if err != nil {
+		return "", err
+	}
+
+	product := 1
+	for _, race := range races {
+		product *= tryRaceVariants(Standard, race.Time, race.Distance)
+	}
+
+	return aoc.Int(product), nil
+}
+
+// Solve2 returns the number of ways to beat the record of the single long
+// race.
+func Solve2(sheet Sheet) (aoc.Answer, error) {
+	totalTime, recordDistance := parseLongRace(sheet)
+
+	numRaces := countWins(totalTime, recordDistance)
+
+	return aoc.Answer(numRaces.String()), nil
+}
+
+func init() {
+	aoc.RegisterParsed(6, parseRows, Solve1, Solve2)
+}
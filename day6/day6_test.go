@@ -0,0 +1,28 @@
+package day6
+
+import (
+	"embed"
+	"testing"
+
+	"aoc2023/aoc/aoctest"
+)
+
+//go:embed example*
+var exampleFiles embed.FS
+
+var examples = []aoctest.Example{
+	{File: "example1", Part: 1, Want: "288"},
+	{File: "example1", Part: 2, Want: "71503"},
+}
+
+func TestExamples(t *testing.T) {
+	aoctest.Run(t, 6, exampleFiles, examples)
+}
+
+func BenchmarkDay6Part1(b *testing.B) {
+	aoctest.Benchmark(b, 6, 1, exampleFiles, examples)
+}
+
+func BenchmarkDay6Part2(b *testing.B) {
+	aoctest.Benchmark(b, 6, 2, exampleFiles, examples)
+}
@@ -0,0 +1,121 @@
+package day6
+
+import "aoc2023/aoc"
+
+// Model describes how far a boat travels in a race.
+type Model interface {
+	// Distance returns the distance if the button is held for pressTime of
+	// the totalTime milliseconds.
+	Distance(totalTime, pressTime int) int
+}
+
+// Boat is a configurable model. The boat gains Acceleration speed for every
+// millisecond the button is held after the first Delay milliseconds, up to
+// MaxSpeed. While moving it loses Drag speed after every millisecond until it
+// stops. A MaxSpeed of 0 is unlimited.
+type Boat struct {
+	Acceleration int
+	MaxSpeed     int
+	Delay        int
+	Drag         int
+}
+
+// Standard is the boat of the puzzle.
+var Standard = Boat{Acceleration: 1}
+
+func (b Boat) Distance(totalTime, pressTime int) int {
+	if pressTime > totalTime {
+		panic("Press time should be less or equal the race time")
+	}
+
+	speed := b.Acceleration * max(0, pressTime-b.Delay)
+	if b.MaxSpeed > 0 {
+		speed = min(speed, b.MaxSpeed)
+	}
+	remainingTime := totalTime - pressTime
+
+	if b.Drag <= 0 || speed <= 0 {
+		return remainingTime * speed
+	}
+
+	// speed, speed - drag, speed - 2*drag, ... for as long as it is positive
+	moving := min(remainingTime, (speed+b.Drag-1)/b.Drag)
+	return moving*speed - b.Drag*moving*(moving-1)/2
+}
+
+// linear reports whether the distance is Acceleration*p*(T-p), which is
+// solved in closed form.
+func (b Boat) linear() bool {
+	return b.Acceleration > 0 && b.MaxSpeed == 0 && b.Delay == 0 && b.Drag == 0
+}
+
+// maxTime is the longest race for which totalTime² fits into an int.
+const maxTime = 3037000499
+
+func race(totalTime int, pressTime int) int {
+	return Standard.Distance(totalTime, pressTime)
+}
+
+// countLinearWins returns the number of press times that beat the record with
+// the Standard boat. The press time p wins if p*(T-p) > D, i.e. if it lies
+// strictly between the roots (T ± sqrt(T² - 4D)) / 2. The integer square root
+// only gives an estimate of the lower bound, which is then corrected by
+// checking its neighbours. The winning press times are symmetric around T/2.
+func countLinearWins(totalTime int, recordDistance int) int {
+	if totalTime > maxTime {
+		panic("race time is too long")
+	}
+	if totalTime <= 0 {
+		return 0
+	}
+	if recordDistance < 0 {
+		// every press time wins, even not pressing at all
+		return totalTime
+	}
+	if recordDistance >= race(totalTime, totalTime/2) {
+		return 0
+	}
+
+	wins := func(pressTime int) bool {
+		return race(totalTime, pressTime) > recordDistance
+	}
+
+	disc := totalTime*totalTime - 4*recordDistance
+	lowest := (totalTime - aoc.ISqrt(disc)) / 2
+	for !wins(lowest) {
+		lowest++
+	}
+	for lowest > 0 && wins(lowest-1) {
+		lowest--
+	}
+
+	return totalTime - 2*lowest + 1
+}
+
+// tryRaceVariants returns the number of press times that beat the record with
+// the model. Boats that only accelerate are solved in closed form, all other
+// models try every press time.
+func tryRaceVariants(model Model, totalTime int, recordDistance int) int {
+	if b, ok := model.(Boat); ok && b.linear() && totalTime <= maxTime {
+		// a*x > D is the same as x > D/a rounded down
+		if recordDistance >= 0 {
+			recordDistance /= b.Acceleration
+		}
+		return countLinearWins(totalTime, recordDistance)
+	}
+
+	return tryRaceVariantsBruteForce(model, totalTime, recordDistance)
+}
+
+// tryRaceVariantsBruteForce tries every press time. It is used for models
+// without a closed form and to cross-check tryRaceVariants.
+func tryRaceVariantsBruteForce(model Model, totalTime int, recordDistance int) int {
+	count := 0
+	for pressTime := 0; pressTime < totalTime; pressTime++ {
+		distance := model.Distance(totalTime, pressTime)
+		if distance > recordDistance {
+			count++
+		}
+	}
+	return count
+}
@@ -0,0 +1,85 @@
+package day6
+
+import (
+	"math"
+	"testing"
+)
+
+func TestCountLinearWins(t *testing.T) {
+	for totalTime := -2; totalTime < 80; totalTime++ {
+		for recordDistance := -3; recordDistance < totalTime*totalTime/4+3; recordDistance++ {
+			got := countLinearWins(totalTime, recordDistance)
+			want := tryRaceVariantsBruteForce(Standard, totalTime, recordDistance)
+			if got != want {
+				t.Errorf("countLinearWins(%v, %v) = %v, want %v", totalTime, recordDistance, got, want)
+			}
+		}
+	}
+}
+
+func TestCountLinearWinsLarge(t *testing.T) {
+	tests := []struct {
+		totalTime, recordDistance, want int
+	}{
+		{71530, 940200, 71503},
+		{30, math.MaxInt, 0},
+		// the record is the best distance or one less
+		{maxTime - 1, (maxTime - 1) / 2 * ((maxTime - 1) / 2), 0},
+		{maxTime - 1, (maxTime-1)/2*((maxTime-1)/2) - 1, 1},
+		{maxTime, (maxTime/2)*(maxTime-maxTime/2) - 1, 2},
+		{maxTime, 0, maxTime - 1},
+	}
+
+	for _, test := range tests {
+		if got := countLinearWins(test.totalTime, test.recordDistance); got != test.want {
+			t.Errorf("countLinearWins(%v, %v) = %v, want %v", test.totalTime, test.recordDistance, got, test.want)
+		}
+	}
+}
+
+func TestBoatDistance(t *testing.T) {
+	tests := []struct {
+		boat                       Boat
+		totalTime, pressTime, want int
+	}{
+		{Standard, 7, 0, 0},
+		{Standard, 7, 3, 12},
+		{Standard, 7, 7, 0},
+		{Boat{Acceleration: 3}, 7, 3, 36},
+		{Boat{Acceleration: 1, MaxSpeed: 2}, 7, 3, 8},
+		{Boat{Acceleration: 1, Delay: 2}, 7, 3, 4},
+		{Boat{Acceleration: 1, Delay: 5}, 7, 3, 0},
+		// 5 + 3 + 1
+		{Boat{Acceleration: 1, Drag: 2}, 20, 5, 9},
+		// 5 + 3, the race ends before the boat stops
+		{Boat{Acceleration: 1, Drag: 2}, 7, 5, 8},
+	}
+
+	for _, test := range tests {
+		if got := test.boat.Distance(test.totalTime, test.pressTime); got != test.want {
+			t.Errorf("%+v.Distance(%v, %v) = %v, want %v", test.boat, test.totalTime, test.pressTime, got, test.want)
+		}
+	}
+}
+
+func TestTryRaceVariants(t *testing.T) {
+	boats := []Boat{
+		Standard,
+		{Acceleration: 3},
+		{Acceleration: 2, MaxSpeed: 9},
+		{Acceleration: 1, Delay: 4},
+		{Acceleration: 2, Drag: 3},
+	}
+
+	for _, boat := range boats {
+		for totalTime := 0; totalTime < 40; totalTime++ {
+			for recordDistance := -2; recordDistance < 300; recordDistance += 7 {
+				got := tryRaceVariants(boat, totalTime, recordDistance)
+				want := tryRaceVariantsBruteForce(boat, totalTime, recordDistance)
+				if got != want {
+					t.Errorf("%+v: tryRaceVariants(%v, %v) = %v, want %v", boat, totalTime, recordDistance, got, want)
+				}
+			}
+		}
+	}
+}
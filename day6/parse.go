@@ -0,0 +1,193 @@
+package day6
+
+import (
+	"io"
+	"math/big"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"aoc2023/aoc"
+)
+
+// Race is one column of the input. Extra holds the values of additional rows
+// of extended variants by their label, e.g. "Penalty".
+type Race struct {
+	Time     int
+	Distance int
+	Extra    map[string]int
+}
+
+type field struct {
+	text   string
+	column int
+}
+
+// row is a labelled line of the input.
+type row struct {
+	label  string
+	fields []field
+	line   int
+	text   string
+}
+
+// Sheet holds the labelled rows of the input. Part 1 reads the numbers of a
+// sheet as separate races, part 2 as one long race.
+type Sheet []row
+
+// requiredLabels are the labels of the first rows, in this order.
+var requiredLabels = []string{"Time", "Distance"}
+
+// splitFields splits str at spaces. The columns start at offset+1.
+func splitFields(str string, offset int) []field {
+	result := make([]field, 0)
+
+	start := -1
+	for i, c := range str + " " {
+		if c != ' ' && start < 0 {
+			start = i
+		} else if c == ' ' && start >= 0 {
+			result = append(result, field{str[start:i], offset + start + 1})
+			start = -1
+		}
+	}
+
+	return result
+}
+
+func isLabel(label string) bool {
+	if label == "" {
+		return false
+	}
+	for _, c := range label {
+		if !unicode.IsLetter(c) {
+			return false
+		}
+	}
+	return true
+}
+
+func parseRow(line string) (row, error) {
+	label, numbers, ok := strings.Cut(line, ":")
+	if !ok {
+		return row{}, aoc.Errorf(1, "expected \"<label>: <numbers>\"")
+	}
+	if !isLabel(label) {
+		return row{}, aoc.Errorf(1, "invalid label %q", label)
+	}
+
+	fields := splitFields(numbers, len(label)+1)
+	if len(fields) == 0 {
+		return row{}, aoc.Errorf(len(label)+2, "no numbers")
+	}
+
+	// every number has to be a positive integer, even if it is concatenated
+	// with the others in part 2
+	for _, f := range fields {
+		for i, c := range f.text {
+			if !aoc.IsDigit(c) {
+				return row{}, aoc.Errorf(f.column+i, "invalid number %q", f.text)
+			}
+		}
+		if strings.Trim(f.text, "0") == "" {
+			return row{}, aoc.Errorf(f.column, "%v has to be positive", label)
+		}
+	}
+
+	return row{label: label, fields: fields}, nil
+}
+
+// parseRows reads the labelled rows of the input. The first rows are Time and
+// Distance, any further rows have a unique label. All rows have the same
+// number of values. Empty lines at the end are ignored.
+func parseRows(r io.Reader) (Sheet, error) {
+	lines, err := aoc.ReadLines(r)
+	if err != nil {
+		return nil, err
+	}
+
+	for len(lines) > 0 && strings.TrimSpace(lines[len(lines)-1]) == "" {
+		lines = lines[:len(lines)-1]
+	}
+
+	if len(lines) < len(requiredLabels) {
+		return nil, aoc.Errorf(0, "expected a time and a distance line, got %v lines", len(lines))
+	}
+
+	rows := make(Sheet, 0, len(lines))
+	labels := make(map[string]int)
+
+	for i, line := range lines {
+		row, err := parseRow(line)
+		if err != nil {
+			return nil, aoc.AtLine(i+1, line, err)
+		}
+		row.line = i + 1
+		row.text = line
+
+		if i < len(requiredLabels) && row.label != requiredLabels[i] {
+			err := aoc.Errorf(1, "expected label %q, got %q", requiredLabels[i], row.label)
+			return nil, aoc.AtLine(row.line, line, err)
+		}
+		if prev, ok := labels[row.label]; ok {
+			err := aoc.Errorf(1, "duplicated label %q, first defined in line %v", row.label, prev)
+			return nil, aoc.AtLine(row.line, line, err)
+		}
+		labels[row.label] = row.line
+
+		if len(rows) > 0 && len(row.fields) != len(rows[0].fields) {
+			err := aoc.Errorf(0, "got %v values for %v races", len(row.fields), len(rows[0].fields))
+			return nil, aoc.AtLine(row.line, line, err)
+		}
+
+		rows = append(rows, row)
+	}
+
+	return rows, nil
+}
+
+// parseRaces returns one race per column.
+func parseRaces(rows Sheet) ([]Race, error) {
+	races := make([]Race, len(rows[0].fields))
+	for _, row := range rows {
+		for i, f := range row.fields {
+			value, err := strconv.Atoi(f.text)
+			if err != nil {
+				err := aoc.Errorf(f.column, "number %v is too large", f.text)
+				return nil, aoc.AtLine(row.line, row.text, err)
+			}
+
+			switch row.label {
+			case "Time":
+				races[i].Time = value
+			case "Distance":
+				races[i].Distance = value
+			default:
+				if races[i].Extra == nil {
+					races[i].Extra = make(map[string]int)
+				}
+				races[i].Extra[row.label] = value
+			}
+		}
+	}
+
+	return races, nil
+}
+
+// parseLongRace returns the time and the distance of the race in part 2, where
+// the numbers of every row are concatenated. The values can be arbitrarily
+// long.
+func parseLongRace(rows Sheet) (totalTime *big.Int, recordDistance *big.Int) {
+	concat := func(row row) *big.Int {
+		var b strings.Builder
+		for _, f := range row.fields {
+			b.WriteString(f.text)
+		}
+
+		// parseRow only accepts digits
+		number, _ := new(big.Int).SetString(b.String(), 10)
+		return number
+	}
+
+	return concat(rows[0]), concat(rows[1])
+}
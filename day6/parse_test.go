@@ -0,0 +1,86 @@
+package day6
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"aoc2023/aoc"
+)
+
+func TestParseRaces(t *testing.T) {
+	const input = "Time:      7  15   30\nDistance:  9  40  200\nPenalty:   1   2    3\n\n"
+
+	rows, err := parseRows(strings.NewReader(input))
+	if err != nil {
+		t.Fatal(err)
+	}
+	races, err := parseRaces(rows)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := []Race{
+		{7, 9, map[string]int{"Penalty": 1}},
+		{15, 40, map[string]int{"Penalty": 2}},
+		{30, 200, map[string]int{"Penalty": 3}},
+	}
+	if len(races) != len(want) {
+		t.Fatalf("got %v races, want %v", len(races), len(want))
+	}
+	for i := range want {
+		got := races[i]
+		if got.Time != want[i].Time || got.Distance != want[i].Distance || got.Extra["Penalty"] != want[i].Extra["Penalty"] {
+			t.Errorf("race %v = %+v, want %+v", i, got, want[i])
+		}
+	}
+}
+
+func TestParseRacesErrors(t *testing.T) {
+	tests := []struct {
+		input        string
+		line, column int
+	}{
+		{"Time: 7\n", 0, 0},
+		{"Time 7\nDistance: 9\n", 1, 1},
+		{"Distance: 9\nTime: 7\n", 1, 1},
+		{"Time: 7 15\nDistance: 9\n", 2, 0},
+		{"Time: 7\nDistance: 9 40\n", 2, 0},
+		{"Time: 7 x5\nDistance: 9 40\n", 1, 9},
+		{"Time: 7 -5\nDistance: 9 40\n", 1, 9},
+		{"Time: 7 0\nDistance: 9 40\n", 1, 9},
+		{"Time:\nDistance:\n", 1, 6},
+		{"Time: 7\nDistance: 9\nTime: 3\n", 3, 1},
+		{"Time: 7\nDistance: 9\nPenalty: 1 2\n", 3, 0},
+		{"Time: 99999999999999999999\nDistance: 9\n", 1, 7},
+	}
+
+	for _, test := range tests {
+		rows, err := parseRows(strings.NewReader(test.input))
+		if err == nil {
+			_, err = parseRaces(rows)
+		}
+
+		var pe *aoc.ParseError
+		if !errors.As(err, &pe) {
+			t.Errorf("%q: got %v, want a ParseError", test.input, err)
+			continue
+		}
+		if pe.Line != test.line || pe.Column != test.column {
+			t.Errorf("%q: error at %v:%v, want %v:%v (%v)", test.input, pe.Line, pe.Column, test.line, test.column, err)
+		}
+	}
+}
+
+func TestParseLongRace(t *testing.T) {
+	const input = "Time: 7 15 30\nDistance: 9 40 200\n"
+
+	rows, err := parseRows(strings.NewReader(input))
+	if err != nil {
+		t.Fatal(err)
+	}
+	totalTime, recordDistance := parseLongRace(rows)
+	if totalTime.String() != "71530" || recordDistance.String() != "940200" {
+		t.Errorf("got %v and %v, want 71530 and 940200", totalTime, recordDistance)
+	}
+}
@@ -0,0 +1,161 @@
+package day7
+
+import (
+	"slices"
+	"testing"
+)
+
+// allHands calls f for every hand of size cards out of n ranks.
+func allHands(size, n int, f func(cards []int)) {
+	cards := make([]int, size)
+	for {
+		f(cards)
+
+		i := 0
+		for i < len(cards) && cards[i] == n-1 {
+			cards[i] = 0
+			i++
+		}
+		if i == len(cards) {
+			return
+		}
+		cards[i]++
+	}
+}
+
+// classifyBruteForce tries every card of the game for every wild card
+// independently and keeps the best type. This is a stronger oracle than the
+// original classification, which replaced all jokers with the same card.
+func classifyBruteForce(rules Rules, cards []int) HandType {
+	hand := slices.Clone(cards)
+
+	var try func(from int) HandType
+	try = func(from int) HandType {
+		for i := from; i < len(hand); i++ {
+			if !rules.wild(cards[i]) {
+				continue
+			}
+
+			best := HandType(0)
+			for rank := range rules.Order {
+				hand[i] = rank
+				best = max(best, try(i+1))
+			}
+			hand[i] = cards[i]
+			return best
+		}
+
+		return classifyHand(hand)
+	}
+
+	return try(0)
+}
+
+func asBytes(cards []int) []byte {
+	result := make([]byte, len(cards))
+	for i, c := range cards {
+		result[i] = byte(c)
+	}
+	return result
+}
+
+func TestClassifyWild(t *testing.T) {
+	tests := []Rules{
+		Standard,
+		Joker,
+		{Order: Standard.Order, Wild: "23", Size: 5},
+		{Order: Standard.Order, Wild: "JQK", Size: 5},
+		{Order: Joker.Order, Wild: "J", Size: 3},
+	}
+
+	for _, rules := range tests {
+		// the type does not depend on the order of the cards, so the brute
+		// force only runs once for every set of cards
+		want := make(map[string]HandType)
+
+		allHands(rules.Size, len(rules.Order), func(cards []int) {
+			sorted := slices.Clone(cards)
+			slices.Sort(sorted)
+			key := string(asBytes(sorted))
+
+			if _, ok := want[key]; !ok {
+				want[key] = classifyBruteForce(rules, sorted)
+			}
+
+			if got := classifyWild(cards, rules.wild); got != want[key] {
+				t.Fatalf("wild %q: %v has type %v, want %v", rules.Wild, cards, got, want[key])
+			}
+		})
+	}
+}
+
+// shapes returns all shapes of hands with n cards and groups of at most size
+// cards from lowest to highest.
+func shapes(n, size int) [][]int {
+	if n == 0 {
+		return [][]int{{}}
+	}
+
+	result := make([][]int, 0)
+	for first := 1; first <= min(n, size); first++ {
+		for _, rest := range shapes(n-first, first) {
+			result = append(result, append([]int{first}, rest...))
+		}
+	}
+	return result
+}
+
+func TestClassifyGroups(t *testing.T) {
+	named := []struct {
+		groups []int
+		want   HandType
+	}{
+		{[]int{1, 1, 1, 1, 1}, HighCard},
+		{[]int{2, 1, 1, 1}, OnePair},
+		{[]int{2, 2, 1}, TwoPair},
+		{[]int{3, 1, 1}, ThreeKind},
+		{[]int{3, 2}, FullHouse},
+		{[]int{4, 1}, FourKind},
+		{[]int{5}, FiveKind},
+	}
+
+	for _, test := range named {
+		if got := classifyGroups(test.groups); got != test.want {
+			t.Errorf("classifyGroups(%v) = %v, want %v", test.groups, got, test.want)
+		}
+	}
+
+	for _, size := range []int{1, 3, 5, 7} {
+		for i, groups := range shapes(size, size) {
+			if got := classifyGroups(groups); got != HandType(i+1) {
+				t.Errorf("classifyGroups(%v) = %v, want %v", groups, got, i+1)
+			}
+		}
+	}
+}
+
+func TestClassifyHandSizes(t *testing.T) {
+	tests := []struct {
+		cards []int
+		want  HandType
+	}{
+		{[]int{1, 2, 3}, 1},
+		{[]int{1, 2, 1}, 2},
+		{[]int{4, 4, 4}, 3},
+		{[]int{1, 2, 3, 4, 5, 6, 7}, 1},
+		// two pairs are below three of a kind
+		{[]int{1, 1, 2, 2, 3, 4, 5}, 3},
+		{[]int{1, 1, 1, 3, 4, 5, 6}, 5},
+		{[]int{6, 6, 6, 6, 6, 6, 6}, 15},
+	}
+
+	for _, test := range tests {
+		cards := slices.Clone(test.cards)
+		if got := classifyHand(cards); got != test.want {
+			t.Errorf("classifyHand(%v) = %v, want %v", test.cards, got, test.want)
+		}
+		if !slices.Equal(cards, test.cards) {
+			t.Errorf("classifyHand(%v) changed the cards to %v", test.cards, cards)
+		}
+	}
+}
@@ -0,0 +1,123 @@
+// Command camelpoker ranks the hands of a day 7 input by the rules of Camel
+// Cards and of poker and prints both ranks side by side, e.g.
+//
+//	camelpoker inputs/day7.txt
+//
+// Cards can have suits like "Th5s5dJc5h", otherwise there are no flushes.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"text/tabwriter"
+
+	"aoc2023/day7"
+)
+
+const usage = `usage: camelpoker [flags] <input>
+
+<input> is a day 7 input or "-" for stdin.
+
+flags:
+`
+
+// withoutSuits removes the suits from every hand, because Camel Cards has
+// none.
+func withoutSuits(deals []day7.Deal) []day7.Deal {
+	result := make([]day7.Deal, len(deals))
+	for i, deal := range deals {
+		if len(deal.Cards) == 2*day7.Poker.Size {
+			ranks := make([]byte, 0, day7.Poker.Size)
+			for j := 0; j < len(deal.Cards); j += 2 {
+				ranks = append(ranks, deal.Cards[j])
+			}
+			deal.Cards = string(ranks)
+		}
+		result[i] = deal
+	}
+
+	return result
+}
+
+// ranking returns the hands and their ranks by line of the input.
+func ranking(rules day7.Rules, deals []day7.Deal) (map[int]day7.Hand, map[int]int, error) {
+	hands, err := rules.Rank(deals)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	byLine := make(map[int]day7.Hand, len(hands))
+	ranks := make(map[int]int, len(hands))
+	for i, h := range hands {
+		byLine[h.Line()] = h
+		ranks[h.Line()] = i + 1
+	}
+
+	return byLine, ranks, nil
+}
+
+func run() error {
+	flags := flag.NewFlagSet("camelpoker", flag.ExitOnError)
+	joker := flags.Bool("joker", false, "use the Camel Cards rules of part 2")
+	flags.Usage = func() {
+		fmt.Fprint(os.Stderr, usage)
+		flags.PrintDefaults()
+	}
+	flags.Parse(os.Args[1:])
+
+	if flags.NArg() != 1 {
+		flags.Usage()
+		os.Exit(2)
+	}
+
+	input := io.Reader(os.Stdin)
+	if flags.Arg(0) != "-" {
+		f, err := os.Open(flags.Arg(0))
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		input = f
+	}
+
+	deals, err := day7.ReadDeals(input)
+	if err != nil {
+		return err
+	}
+
+	camel := day7.Standard
+	if *joker {
+		camel = day7.Joker
+	}
+
+	camelHands, camelRanks, err := ranking(camel, withoutSuits(deals))
+	if err != nil {
+		return err
+	}
+	pokerHands, pokerRanks, err := ranking(day7.Poker, deals)
+	if err != nil {
+		return err
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 8, 2, ' ', 0)
+	fmt.Fprintln(w, "hand\tbid\tcamel\t\tpoker")
+	for line := 1; line <= len(camelHands); line++ {
+		c := camelHands[line]
+		p := pokerHands[line]
+		fmt.Fprintf(w, "%v\t%v\t%v\t%v\t%v\t%v\n",
+			day7.Poker.Format(p), c.Bid(),
+			camelRanks[line], camel.TypeName(c.Type()),
+			pokerRanks[line], day7.Poker.TypeName(p.Type()))
+	}
+
+	return w.Flush()
+}
+
+func main() {
+	if err := run(); err != nil {
+		fmt.Fprintf(os.Stderr, "camelpoker: %v\n", err)
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,174 @@
+package day7
+
+import (
+	"slices"
+
+	"aoc2023/aoc"
+)
+
+type Hand struct {
+	cards []int
+	// suits of the cards, nil if the input has none
+	suits    []rune
+	bid      int
+	handType HandType
+	// line of the hand in the input, 0 if unknown
+	line int
+}
+
+func (h Hand) Bid() int {
+	return h.bid
+}
+
+func (h Hand) Line() int {
+	return h.line
+}
+
+func (h Hand) Type() HandType {
+	return h.handType
+}
+
+type HandType int
+
+// HandType is the rank of the shape of a hand, i.e. the sizes of its groups of
+// equal cards. The named types are those of hands with 5 cards, hands of other
+// sizes are numbered the same way from HighCard (all cards are different) to
+// n of a kind.
+const (
+	// from lowest to highest
+	HighCard HandType = iota + 1
+	OnePair
+	TwoPair
+	ThreeKind
+	FullHouse
+	FourKind
+	FiveKind
+)
+
+// The types that only exist in poker are negative, so that they never collide
+// with the shape of a hand of any size. See pokerStrength for their order.
+const (
+	Straight HandType = -(iota + 1)
+	Flush
+	StraightFlush
+)
+
+// handTypeNames are the names of the types of hands with 5 cards.
+var handTypeNames = map[HandType]string{
+	HighCard:      "high card",
+	OnePair:       "one pair",
+	TwoPair:       "two pair",
+	ThreeKind:     "three of a kind",
+	FullHouse:     "full house",
+	FourKind:      "four of a kind",
+	FiveKind:      "five of a kind",
+	Straight:      "straight",
+	Flush:         "flush",
+	StraightFlush: "straight flush",
+}
+
+// classifyHand returns the type of a hand without wild cards.
+func classifyHand(cards []int) HandType {
+	return classifyWild(cards, func(int) bool { return false })
+}
+
+// groupSizes returns the sizes of the groups of equal cards from largest to
+// smallest and the number of wild cards, which are not part of any group.
+func groupSizes(cards []int, wild func(card int) bool) (groups []int, wilds int) {
+	sorted := slices.Clone(cards)
+	slices.Sort(sorted)
+
+	groups = make([]int, 0, len(sorted))
+	for i, c := range sorted {
+		switch {
+		case wild(c):
+			wilds++
+		case i > 0 && sorted[i-1] == c:
+			groups[len(groups)-1]++
+		default:
+			groups = append(groups, 1)
+		}
+	}
+
+	slices.Sort(groups)
+	slices.Reverse(groups)
+	return groups, wilds
+}
+
+// partitions returns the number of ways to split n cards into groups of at
+// most size cards.
+func partitions(n, size int) int {
+	if n == 0 {
+		return 1
+	}
+	if size == 0 {
+		return 0
+	}
+	if size > n {
+		return partitions(n, n)
+	}
+	return partitions(n, size-1) + partitions(n-size, size)
+}
+
+// classifyGroups returns the type of a hand with the group sizes sorted from
+// largest to smallest. The shapes of all hands of the same size are ordered by
+// the largest group, then by the second largest and so on. The type is one
+// more than the number of shapes below this one, which are counted by fixing
+// the groups before i and making group i smaller.
+func classifyGroups(groups []int) HandType {
+	remaining := 0
+	for _, g := range groups {
+		remaining += g
+	}
+
+	below := 0
+	for _, g := range groups {
+		for smaller := 1; smaller < g; smaller++ {
+			// the rest has groups of at most smaller cards
+			below += partitions(remaining-smaller, smaller)
+		}
+		remaining -= g
+	}
+
+	return HandType(below + 1)
+}
+
+// classifyWild returns the best type of the hand if the cards for which wild
+// is true can be replaced by any other card. The wild cards always join the
+// largest group, because that gives the highest type.
+func classifyWild(cards []int, wild func(card int) bool) HandType {
+	groups, wilds := groupSizes(cards, wild)
+	if len(groups) == 0 {
+		groups = append(groups, 0)
+	}
+	groups[0] += wilds
+
+	return classifyGroups(groups)
+}
+
+// cmpHands compares the types and then the cards in the order they were
+// dealt.
+func cmpHands(a, b Hand) int {
+	if a.handType < b.handType {
+		return -1
+	}
+	if a.handType > b.handType {
+		return 1
+	}
+
+	return slices.Compare(a.cards, b.cards)
+}
+
+// Solve1 returns the total winnings of all hands.
+func Solve1(deals []Deal) (aoc.Answer, error) {
+	return Standard.Winnings(deals)
+}
+
+// Solve2 returns the total winnings of all hands when J is a joker.
+func Solve2(deals []Deal) (aoc.Answer, error) {
+	return Joker.Winnings(deals)
+}
+
+func init() {
+	aoc.RegisterParsed(7, ReadDeals, Solve1, Solve2)
+}
@@ -0,0 +1,60 @@
+package day7
+
+import (
+	"embed"
+	"testing"
+
+	"aoc2023/aoc/aoctest"
+)
+
+//go:embed example*
+var exampleFiles embed.FS
+
+var examples = []aoctest.Example{
+	{File: "example1", Part: 1, Want: "6440"},
+	{File: "example1", Part: 2, Want: "5905"},
+}
+
+func TestExamples(t *testing.T) {
+	aoctest.Run(t, 7, exampleFiles, examples)
+}
+
+func BenchmarkDay7Part1(b *testing.B) {
+	aoctest.Benchmark(b, 7, 1, exampleFiles, examples)
+}
+
+func BenchmarkDay7Part2(b *testing.B) {
+	aoctest.Benchmark(b, 7, 2, exampleFiles, examples)
+}
+
+func BenchmarkClassifyHand(b *testing.B) {
+	hands := [][]int{
+		{1, 0, 8, 1, 11},
+		{8, 3, 3, 9, 3},
+		{11, 11, 4, 5, 5},
+		{11, 8, 9, 9, 8},
+		{10, 10, 10, 9, 12},
+	}
+
+	for i := 0; i < b.N; i++ {
+		for _, h := range hands {
+			classifyHand(h)
+		}
+	}
+}
+
+func BenchmarkClassifyHandWithJoker(b *testing.B) {
+	hands := [][]int{
+		{2, 1, 9, 2, 11},
+		{9, 4, 4, 0, 4},
+		{11, 11, 5, 6, 6},
+		{11, 9, 0, 0, 9},
+		{10, 10, 10, 0, 12},
+	}
+
+	for i := 0; i < b.N; i++ {
+		for _, h := range hands {
+			Joker.Classify(h)
+		}
+	}
+}
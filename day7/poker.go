@@ -0,0 +1,101 @@
+package day7
+
+import (
+	"cmp"
+	"slices"
+)
+
+// pokerOrder lists the hand types of poker from lowest to highest. Five of a
+// kind needs more than one deck, but the Camel Cards input has it.
+var pokerOrder = []HandType{
+	HighCard,
+	OnePair,
+	TwoPair,
+	ThreeKind,
+	Straight,
+	Flush,
+	FullHouse,
+	FourKind,
+	StraightFlush,
+	FiveKind,
+}
+
+// pokerStrength returns the position of the type in pokerOrder, starting at 1.
+func pokerStrength(t HandType) HandType {
+	return HandType(slices.Index(pokerOrder, t) + 1)
+}
+
+// kickers returns the cards of the largest group first, then those of the next
+// group and so on. Groups of the same size are sorted from highest to lowest.
+func kickers(cards []int) []int {
+	count := make(map[int]int)
+	for _, c := range cards {
+		count[c]++
+	}
+
+	result := slices.Clone(cards)
+	slices.SortFunc(result, func(a, b int) int {
+		if c := cmp.Compare(count[b], count[a]); c != 0 {
+			return c
+		}
+		return cmp.Compare(b, a)
+	})
+	return result
+}
+
+// isWheel reports whether the kickers are A5432, the lowest straight, with
+// the standard order of cards.
+func isWheel(kickers []int) bool {
+	return slices.Equal(kickers, []int{12, 3, 2, 1, 0})
+}
+
+// isStraight reports whether the cards are consecutive. The ace counts as the
+// highest and as the lowest card.
+func isStraight(cards []int) bool {
+	sorted := kickers(cards)
+	if isWheel(sorted) {
+		return true
+	}
+
+	for i := 1; i < len(sorted); i++ {
+		if sorted[i] != sorted[i-1]-1 {
+			return false
+		}
+	}
+	return true
+}
+
+func isFlush(suits []rune) bool {
+	if len(suits) == 0 {
+		return false
+	}
+
+	for _, s := range suits {
+		if s != suits[0] {
+			return false
+		}
+	}
+	return true
+}
+
+// classifyPoker returns the type of a poker hand. Without suits there are no
+// flushes.
+func classifyPoker(cards []int, suits []rune) HandType {
+	groupType := classifyHand(cards)
+	straight := isStraight(cards)
+	flush := isFlush(suits)
+
+	switch {
+	case groupType == FiveKind:
+		return FiveKind
+	case straight && flush:
+		return StraightFlush
+	case groupType == FourKind || groupType == FullHouse:
+		return groupType
+	case flush:
+		return Flush
+	case straight:
+		return Straight
+	}
+	return groupType
+}
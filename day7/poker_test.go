@@ -0,0 +1,152 @@
+package day7
+
+import "testing"
+
+func TestClassifyPoker(t *testing.T) {
+	tests := []struct {
+		cards string
+		want  HandType
+	}{
+		{"32T3K", OnePair},
+		{"23456", Straight},
+		{"A2345", Straight},
+		{"TJQKA", Straight},
+		{"QKA23", HighCard},
+		{"2h3h4h5h6h", StraightFlush},
+		{"2h9h4h5hKh", Flush},
+		{"2h2d2c5h5s", FullHouse},
+		{"2h2d2c2s5s", FourKind},
+		{"22222", FiveKind},
+		{"2h3d4h5h6h", Straight},
+	}
+
+	for _, test := range tests {
+		hand, err := Poker.Parse(test.cards + " 1")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if hand.handType != test.want {
+			t.Errorf("%v has type %v, want %v", test.cards, hand.handType, test.want)
+		}
+	}
+}
+
+func TestComparePoker(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want int
+	}{
+		{"23456", "A2345", 1},
+		{"TJQKA", "9TJQK", 1},
+		{"AAKKQ", "AAKKJ", 1},
+		{"33AKQ", "22AKQ", 1},
+		// the pair decides before the kickers
+		{"A2KK3", "KKQA4", -1},
+		{"2h9h4h5hKh", "TJQKA", 1},
+		{"2h2d2c5h5s", "2h9h4h5hKh", 1},
+		{"2h3h4h5h6h", "2h2d2c2s5s", 1},
+		{"KTJJT", "KK677", -1},
+		{"32T3K", "32T3K", 0},
+	}
+
+	for _, test := range tests {
+		a, err := Poker.Parse(test.a + " 1")
+		if err != nil {
+			t.Fatal(err)
+		}
+		b, err := Poker.Parse(test.b + " 1")
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if got := Poker.Compare(a, b); got != test.want {
+			t.Errorf("Compare(%v, %v) = %v, want %v", test.a, test.b, got, test.want)
+		}
+	}
+}
+
+func TestPokerWinnings(t *testing.T) {
+	input, err := exampleFiles.ReadFile("example1")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	deals := readDeals(t, string(input))
+	got, err := Poker.Winnings(deals)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got != "6440" {
+		t.Errorf("Winnings() = %v, want 6440", got)
+	}
+
+	wild := Poker
+	wild.Wild = "J"
+	if _, err := wild.Winnings(deals); err == nil {
+		t.Errorf("poker with wild cards: got no error")
+	}
+
+	invalid := []struct {
+		rules Rules
+		line  string
+	}{
+		{Poker, "2x3h4h5h6h 1"},
+		{Poker, "AhAhAhAhAh 1"},
+		{Poker, "2h3h4h5h2h 1"},
+		{Standard, "2h3h4h5h6h 1"},
+		{Joker, "2h3h4h5h6h 1"},
+	}
+	for _, test := range invalid {
+		if _, err := test.rules.Parse(test.line); err == nil {
+			t.Errorf("%+v: %q got no error", test.rules, test.line)
+		}
+	}
+
+	// the same rank in different suits is fine
+	hand, err := Poker.Parse("AhAdAcAsKh 1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if hand.handType != FourKind {
+		t.Errorf("AhAdAcAsKh has type %v, want %v", hand.handType, FourKind)
+	}
+}
+
+func TestFormat(t *testing.T) {
+	for _, cards := range []string{"T55J5", "Th5s5dJc5h"} {
+		hand, err := Poker.Parse(cards + " 1")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := Poker.Format(hand); got != cards {
+			t.Errorf("Format() = %v, want %v", got, cards)
+		}
+	}
+}
+
+func TestTypeName(t *testing.T) {
+	seven := Rules{Order: Standard.Order, Size: 7}
+
+	hand, err := seven.Parse("2223334 1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if hand.Type() != 8 {
+		t.Fatalf("2223334 has type %v, want 8", int(hand.Type()))
+	}
+	if got := seven.TypeName(hand.Type()); got != "type 8" {
+		t.Errorf("TypeName() = %v, want type 8", got)
+	}
+	if got := seven.TypeName(FullHouse); got != "type 5" {
+		t.Errorf("TypeName(FullHouse) = %v with 7 cards, want type 5", got)
+	}
+	if got := Poker.TypeName(StraightFlush); got != "straight flush" {
+		t.Errorf("TypeName(StraightFlush) = %v, want straight flush", got)
+	}
+
+	for _, poker := range []HandType{Straight, Flush, StraightFlush} {
+		if poker > 0 {
+			t.Errorf("%v collides with the shape of a hand", poker)
+		}
+	}
+}
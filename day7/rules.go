@@ -0,0 +1,319 @@
+package day7
+
+import (
+	"fmt"
+	"io"
+	"slices"
+	"strconv"
+	"strings"
+
+	"aoc2023/aoc"
+)
+
+// TieBreak decides the order of hands of the same type.
+type TieBreak int
+
+const (
+	// InOrder compares the cards in the order they were dealt.
+	InOrder TieBreak = iota
+	// HighestFirst compares the cards sorted from highest to lowest.
+	HighestFirst
+	// Kickers compares the cards of the largest group first, then those of
+	// the next group and so on, like in poker. Groups of the same size are
+	// compared from highest to lowest.
+	Kickers
+)
+
+// Ranking decides which hand types exist and how they are ordered.
+type Ranking int
+
+const (
+	// CamelCards only knows groups of equal cards.
+	CamelCards Ranking = iota
+	// PokerRanking adds straights and flushes, see classifyPoker.
+	PokerRanking
+)
+
+// Rules describe a variant of Camel Cards.
+type Rules struct {
+	// Order lists the cards from lowest to highest.
+	Order string
+	// Wild lists the cards that count as any other card for the type of a
+	// hand. They keep their position in Order for breaking ties.
+	Wild string
+	// Size is the number of cards of a hand, e.g. 3, 5 or 7.
+	Size     int
+	TieBreak TieBreak
+	Ranking  Ranking
+}
+
+// Standard are the rules of part 1.
+var Standard = Rules{
+	Order: "23456789TJQKA",
+	Size:  5,
+}
+
+// Joker are the rules of part 2, J is the weakest card but counts as any
+// other card.
+var Joker = Rules{
+	Order: "J23456789TQKA",
+	Wild:  "J",
+	Size:  5,
+}
+
+// Poker ranks the hands by the rules of poker. Suits are optional, without
+// them there are no flushes.
+var Poker = Rules{
+	Order:    "23456789TJQKA",
+	Size:     5,
+	TieBreak: Kickers,
+	Ranking:  PokerRanking,
+}
+
+// suits are the suits of poker cards, which follow the rank, e.g. "Th".
+const suits = "cdhs"
+
+// card returns the rank of the card or -1 if it is not part of the game.
+func (rules Rules) card(c rune) int {
+	return strings.IndexRune(rules.Order, c)
+}
+
+func (rules Rules) wild(card int) bool {
+	return strings.IndexByte(rules.Wild, rules.Order[card]) >= 0
+}
+
+// Classify returns the type of the hand without suits.
+func (rules Rules) Classify(cards []int) HandType {
+	return rules.classify(Hand{cards: cards})
+}
+
+func (rules Rules) classify(h Hand) HandType {
+	if rules.Ranking == PokerRanking {
+		return classifyPoker(h.cards, h.suits)
+	}
+	return classifyWild(h.cards, rules.wild)
+}
+
+// key returns a copy of the hand for cmpHands, with the type replaced by its
+// strength and the cards in the order of the tie-break rule.
+func (rules Rules) key(h Hand) Hand {
+	if rules.Ranking == PokerRanking {
+		h.handType = pokerStrength(h.handType)
+	}
+
+	switch rules.TieBreak {
+	case HighestFirst:
+		h.cards = slices.Clone(h.cards)
+		slices.Sort(h.cards)
+		slices.Reverse(h.cards)
+	case Kickers:
+		h.cards = kickers(h.cards)
+		if rules.Ranking == PokerRanking && isWheel(h.cards) {
+			// the ace is the lowest card of A2345
+			h.cards = append(h.cards[1:], -1)
+		}
+	}
+
+	return h
+}
+
+// Compare orders hands by type and then by the tie-break rule.
+func (rules Rules) Compare(a, b Hand) int {
+	return cmpHands(rules.key(a), rules.key(b))
+}
+
+// TypeName returns the name of the type for hands of these rules. Only the
+// types of hands with 5 cards have names, the others are numbered.
+func (rules Rules) TypeName(t HandType) string {
+	if name, ok := handTypeNames[t]; ok && rules.Size == 5 {
+		return name
+	}
+	return fmt.Sprintf("type %v", int(t))
+}
+
+// Format returns the cards of the hand as in the input.
+func (rules Rules) Format(h Hand) string {
+	var b strings.Builder
+	for i, card := range h.cards {
+		b.WriteByte(rules.Order[card])
+		if h.suits != nil {
+			b.WriteRune(h.suits[i])
+		}
+	}
+	return b.String()
+}
+
+// parseCards reads the cards of a hand, either only the ranks ("T55J5") or,
+// in poker, the ranks followed by the suits ("Th5s5dJc5h"). A card with a suit
+// can only be dealt once.
+func (rules Rules) parseCards(str string) (Hand, error) {
+	withSuits := rules.Ranking == PokerRanking && len(str) == 2*rules.Size
+	if len(str) != rules.Size && !withSuits {
+		return Hand{}, aoc.Errorf(1, "a hand must have %v cards", rules.Size)
+	}
+
+	result := Hand{cards: make([]int, 0, rules.Size)}
+	dealt := make(map[string]int)
+	if withSuits {
+		result.suits = make([]rune, 0, rules.Size)
+	}
+
+	for i, s := range str {
+		if withSuits && i%2 == 1 {
+			if !strings.ContainsRune(suits, s) {
+				return Hand{}, aoc.Errorf(i+1, "unrecognized suit %q", s)
+			}
+
+			card := str[i-1 : i+1]
+			if prev, ok := dealt[card]; ok {
+				return Hand{}, aoc.Errorf(i, "card %v was already dealt in column %v", card, prev)
+			}
+			dealt[card] = i
+
+			result.suits = append(result.suits, s)
+			continue
+		}
+
+		card := rules.card(s)
+		if card == -1 {
+			return Hand{}, aoc.Errorf(i+1, "unrecognized card %q", s)
+		}
+
+		result.cards = append(result.cards, card)
+	}
+
+	return result, nil
+}
+
+// Deal is a line of the input with the cards not yet read by any rules.
+type Deal struct {
+	Cards string
+	Bid   int
+	// Line of the deal in the input, 0 if unknown
+	Line int
+	text string
+}
+
+// ParseDeal reads a line "<cards> <bid>".
+func ParseDeal(line string) (Deal, error) {
+	str := strings.Split(line, " ")
+
+	if len(str) != 2 {
+		return Deal{}, aoc.Errorf(1, "expected \"<cards> <bid>\"")
+	}
+
+	bid, err := strconv.Atoi(str[1])
+	if err != nil {
+		return Deal{}, aoc.Errorf(len(str[0])+2, "invalid bid %q", str[1])
+	}
+
+	return Deal{Cards: str[0], Bid: bid, text: line}, nil
+}
+
+// ReadDeals reads all lines of the input, so that they can be ranked by
+// different rules.
+func ReadDeals(r io.Reader) ([]Deal, error) {
+	lines, err := aoc.ReadLines(r)
+	if err != nil {
+		return nil, err
+	}
+
+	deals := make([]Deal, 0, len(lines))
+	for i, line := range lines {
+		deal, err := ParseDeal(line)
+		if err != nil {
+			return nil, aoc.AtLine(i+1, line, err)
+		}
+		deal.Line = i + 1
+		deals = append(deals, deal)
+	}
+
+	return deals, nil
+}
+
+// Hand reads the cards of the deal.
+func (rules Rules) Hand(d Deal) (Hand, error) {
+	result, err := rules.parseCards(d.Cards)
+	if err != nil {
+		return Hand{}, err
+	}
+	result.handType = rules.classify(result)
+	result.bid = d.Bid
+	result.line = d.Line
+
+	return result, nil
+}
+
+// Parse reads a line "<cards> <bid>".
+func (rules Rules) Parse(line string) (Hand, error) {
+	deal, err := ParseDeal(line)
+	if err != nil {
+		return Hand{}, err
+	}
+
+	return rules.Hand(deal)
+}
+
+// check reports rules that cannot be played.
+func (rules Rules) check() error {
+	if rules.Size <= 0 {
+		return fmt.Errorf("hands of %v cards are not supported", rules.Size)
+	}
+	for _, c := range rules.Wild {
+		if rules.card(c) == -1 {
+			return fmt.Errorf("wild card %q is not part of the game", c)
+		}
+	}
+	if rules.Ranking == PokerRanking && (rules.Size != 5 || rules.Wild != "") {
+		return fmt.Errorf("poker is only supported with 5 cards and without wild cards")
+	}
+
+	return nil
+}
+
+// Rank returns the hands of all deals from the weakest to the strongest.
+// Equal hands keep the order of the deals.
+func (rules Rules) Rank(deals []Deal) ([]Hand, error) {
+	if err := rules.check(); err != nil {
+		return nil, err
+	}
+
+	// the keys are computed once per hand and not in every comparison
+	type keyed struct {
+		hand, key Hand
+	}
+
+	ranked := make([]keyed, 0, len(deals))
+	for _, deal := range deals {
+		hand, err := rules.Hand(deal)
+		if err != nil {
+			return nil, aoc.AtLine(deal.Line, deal.text, err)
+		}
+		ranked = append(ranked, keyed{hand, rules.key(hand)})
+	}
+
+	slices.SortStableFunc(ranked, func(a, b keyed) int {
+		return cmpHands(a.key, b.key)
+	})
+
+	hands := make([]Hand, len(ranked))
+	for i, r := range ranked {
+		hands[i] = r.hand
+	}
+	return hands, nil
+}
+
+// Winnings returns the total winnings of all deals, which is the sum of the
+// bids multiplied with the rank of the hand.
+func (rules Rules) Winnings(deals []Deal) (aoc.Answer, error) {
+	hands, err := rules.Rank(deals)
+	if err != nil {
+		return "", err
+	}
+
+	sum := 0
+	for i, h := range hands {
+		sum += (i + 1) * h.bid
+	}
+	return aoc.Int(sum), nil
+}
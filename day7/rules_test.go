@@ -0,0 +1,113 @@
+package day7
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestRulesClassify(t *testing.T) {
+	twoWild := Rules{Order: Standard.Order, Wild: "23", Size: 5}
+
+	tests := []struct {
+		rules Rules
+		cards string
+		want  HandType
+	}{
+		{Standard, "32T3K", OnePair},
+		{Standard, "KTJJT", TwoPair},
+		{Joker, "KTJJT", FourKind},
+		{Joker, "JJJJJ", FiveKind},
+		{Joker, "2345J", OnePair},
+		{twoWild, "23KK4", FourKind},
+		{twoWild, "2233A", FiveKind},
+		{twoWild, "AKQJT", HighCard},
+	}
+
+	for _, test := range tests {
+		hand, err := test.rules.Parse(test.cards + " 1")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if hand.handType != test.want {
+			t.Errorf("%+v: %v has type %v, want %v", test.rules, test.cards, hand.handType, test.want)
+		}
+	}
+}
+
+func TestRulesCompare(t *testing.T) {
+	highestFirst := Rules{Order: Standard.Order, Size: 5, TieBreak: HighestFirst}
+
+	tests := []struct {
+		rules Rules
+		a, b  string
+		want  int
+	}{
+		{Standard, "KK677", "KTJJT", 1},
+		{Joker, "KK677", "KTJJT", -1},
+		{Standard, "23456", "65432", -1},
+		{highestFirst, "23456", "65432", 0},
+		{highestFirst, "2345A", "KQJT9", 1},
+		{highestFirst, "A2KK3", "KKQA4", -1},
+	}
+
+	for _, test := range tests {
+		a, err := test.rules.Parse(test.a + " 1")
+		if err != nil {
+			t.Fatal(err)
+		}
+		b, err := test.rules.Parse(test.b + " 1")
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if got := test.rules.Compare(a, b); got != test.want {
+			t.Errorf("%+v: Compare(%v, %v) = %v, want %v", test.rules, test.a, test.b, got, test.want)
+		}
+	}
+}
+
+func readDeals(t *testing.T, input string) []Deal {
+	t.Helper()
+
+	deals, err := ReadDeals(strings.NewReader(input))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return deals
+}
+
+func TestRulesCheck(t *testing.T) {
+	tests := []Rules{
+		{Order: Standard.Order, Size: 0},
+		{Order: Standard.Order, Wild: "X", Size: 5},
+	}
+
+	for _, rules := range tests {
+		if _, err := rules.Winnings(nil); err == nil {
+			t.Errorf("%+v: got no error", rules)
+		}
+	}
+}
+
+func TestWinningsHandSizes(t *testing.T) {
+	tests := []struct {
+		rules Rules
+		input string
+		want  string
+	}{
+		// KKA is a pair, 2AQ is the lowest high card
+		{Rules{Order: Standard.Order, Size: 3}, "KKA 5\nAQ2 7\n2AQ 11\nQQQ 13\n", "92"},
+		// QQJJ is four of a kind, 2233 stays two pairs
+		{Rules{Order: Joker.Order, Wild: "J", Size: 7}, "QQJJ234 2\n2233456 3\n", "7"},
+	}
+
+	for _, test := range tests {
+		got, err := test.rules.Winnings(readDeals(t, test.input))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(got) != test.want {
+			t.Errorf("%+v: Winnings(%q) = %v, want %v", test.rules, test.input, got, test.want)
+		}
+	}
+}
@@ -0,0 +1,180 @@
+package day8
+
+import (
+	"io"
+	"regexp"
+
+	"aoc2023/aoc"
+)
+
+type Pair struct {
+	left  string
+	right string
+}
+
+func parseNode(line string) (string, Pair, error) {
+	regex := regexp.MustCompile(`^([A-Z0-9]+) = \(([A-Z0-9]+), ([A-Z0-9]+)\)`)
+
+	parts := regex.FindStringSubmatch(line)
+	if len(parts) != 4 {
+		return "", Pair{}, aoc.Errorf(1, "expected \"<node> = (<left>, <right>)\"")
+	}
+
+	return parts[1], Pair{parts[2], parts[3]}, nil
+}
+
+func parseSequence(line string) (string, error) {
+	if len(line) == 0 {
+		return "", aoc.Errorf(1, "empty L-R sequence")
+	}
+
+	for i, s := range line {
+		if s != 'L' && s != 'R' {
+			return "", aoc.Errorf(i+1, "invalid sequence code %q", s)
+		}
+	}
+
+	return line, nil
+}
+
+// Network is the parsed input, the L-R sequence and the nodes by name.
+type Network struct {
+	Sequence string
+	Nodes    map[string]Pair
+}
+
+func readNetwork(r io.Reader) (Network, error) {
+	lines, err := aoc.ReadLines(r)
+	if err != nil {
+		return Network{}, err
+	}
+
+	if len(lines) < 3 {
+		return Network{}, aoc.Errorf(0, "expected the L-R sequence, an empty line and the nodes")
+	}
+
+	// L-R sequence
+	sequence, err := parseSequence(lines[0])
+	if err != nil {
+		return Network{}, aoc.AtLine(1, lines[0], err)
+	}
+
+	if len(lines[1]) != 0 {
+		return Network{}, aoc.AtLine(2, lines[1], aoc.Errorf(1, "expected an empty line"))
+	}
+
+	nodes := make(map[string]Pair)
+	defined := make(map[string]int)
+	for i, line := range lines[2:] {
+		name, node, err := parseNode(line)
+		if err != nil {
+			return Network{}, aoc.AtLine(i+3, line, err)
+		}
+
+		if prev, ok := defined[name]; ok {
+			err := aoc.Errorf(1, "duplicated node %v, first defined in line %v", name, prev)
+			return Network{}, aoc.AtLine(i+3, line, err)
+		}
+		defined[name] = i + 3
+		nodes[name] = node
+	}
+
+	for name, node := range nodes {
+		for _, next := range []string{node.left, node.right} {
+			if _, ok := nodes[next]; !ok {
+				return Network{}, aoc.Errorf(0, "node %v refers to the unknown node %v", name, next)
+			}
+		}
+	}
+
+	return Network{sequence, nodes}, nil
+}
+
+// iteratePath follows the sequence from startNode until a node for which
+// isTarget is true is reached and returns the number of steps. If a node is
+// reached again at the start of the sequence the path is a loop that never
+// reaches a target.
+func iteratePath(startNode string, nodes map[string]Pair, sequence string, isTarget func(node string) bool) (int, error) {
+	steps := 0
+	curNode := startNode
+	seen := make(map[string]bool)
+
+	for !seen[curNode] {
+		seen[curNode] = true
+
+		for _, s := range sequence {
+			if s == 'L' {
+				curNode = nodes[curNode].left
+			} else if s == 'R' {
+				curNode = nodes[curNode].right
+			} else {
+				panic("invalid sequence code")
+			}
+
+			steps++
+			if isTarget(curNode) {
+				return steps, nil
+			}
+		}
+	}
+
+	return 0, aoc.Errorf(0, "the path from %v never reaches a target node", startNode)
+}
+
+// Solve1 returns the number of steps from AAA to ZZZ.
+func Solve1(network Network) (aoc.Answer, error) {
+	sequence, nodes := network.Sequence, network.Nodes
+
+	if _, ok := nodes["AAA"]; !ok {
+		return "", aoc.Errorf(0, "missing start node AAA")
+	}
+
+	if _, ok := nodes["ZZZ"]; !ok {
+		return "", aoc.Errorf(0, "missing target node ZZZ")
+	}
+
+	steps, err := iteratePath("AAA", nodes, sequence, func(node string) bool {
+		return node == "ZZZ"
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return aoc.Int(steps), nil
+}
+
+// Solve2 returns the number of steps until all nodes ending with A reach nodes
+// ending with Z at the same time.
+func Solve2(network Network) (aoc.Answer, error) {
+	sequence, nodes := network.Sequence, network.Nodes
+
+	var curNodes []string
+	for name := range nodes {
+		if name[len(name)-1] == 'A' {
+			curNodes = append(curNodes, name)
+		}
+	}
+
+	if len(curNodes) == 0 {
+		return "", aoc.Errorf(0, "no start node ending with A")
+	}
+
+	steps := make([]int, len(curNodes))
+	for i, n := range curNodes {
+		var err error
+		steps[i], err = iteratePath(n, nodes, sequence, func(node string) bool {
+			return node[len(node)-1] == 'Z'
+		})
+		if err != nil {
+			return "", err
+		}
+	}
+
+	totalSteps := aoc.LCMOf(steps...)
+
+	return aoc.Int(totalSteps), nil
+}
+
+func init() {
+	aoc.RegisterParsed(8, readNetwork, Solve1, Solve2)
+}
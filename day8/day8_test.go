@@ -0,0 +1,81 @@
+package day8
+
+import (
+	"embed"
+	"errors"
+	"strings"
+	"testing"
+
+	"aoc2023/aoc"
+	"aoc2023/aoc/aoctest"
+)
+
+//go:embed example*
+var exampleFiles embed.FS
+
+var examples = []aoctest.Example{
+	{File: "example1", Part: 1, Want: "2"},
+	{File: "example2", Part: 1, Want: "6"},
+	{File: "example3", Part: 2, Want: "6"},
+}
+
+func TestExamples(t *testing.T) {
+	aoctest.Run(t, 8, exampleFiles, examples)
+}
+
+func BenchmarkDay8Part1(b *testing.B) {
+	aoctest.Benchmark(b, 8, 1, exampleFiles, examples)
+}
+
+func BenchmarkDay8Part2(b *testing.B) {
+	aoctest.Benchmark(b, 8, 2, exampleFiles, examples)
+}
+
+func TestNoStartNodes(t *testing.T) {
+	network, err := readNetwork(strings.NewReader("LR\n\nBBB = (BBB, BBB)\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := Solve2(network); err == nil {
+		t.Errorf("got no error for a network without start nodes")
+	}
+}
+
+func TestUnreachableTarget(t *testing.T) {
+	tests := []string{
+		"LR\n\nAAA = (BBB, BBB)\nBBB = (AAA, AAA)\n",
+		"LR\n\nAAA = (BBB, BBB)\nBBB = (AAA, AAA)\nZZZ = (ZZZ, ZZZ)\n",
+	}
+
+	for _, input := range tests {
+		network, err := readNetwork(strings.NewReader(input))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if _, err := Solve1(network); err == nil {
+			t.Errorf("%q: got no error", input)
+		}
+	}
+
+	network, err := readNetwork(strings.NewReader("L\n\n11A = (11B, 11B)\n11B = (11A, 11A)\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := Solve2(network); err == nil {
+		t.Errorf("got no error for a loop without a node ending with Z")
+	}
+}
+
+func TestDuplicatedNode(t *testing.T) {
+	_, err := readNetwork(strings.NewReader("L\n\nAAA = (ZZZ, ZZZ)\nZZZ = (ZZZ, ZZZ)\nAAA = (AAA, AAA)\n"))
+
+	var pe *aoc.ParseError
+	if !errors.As(err, &pe) || pe.Line != 5 {
+		t.Fatalf("got %v, want a ParseError in line 5", err)
+	}
+	if !strings.Contains(pe.Err.Error(), "line 3") {
+		t.Errorf("error %q does not name the first definition", pe.Err)
+	}
+}
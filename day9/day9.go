@@ -0,0 +1,100 @@
+package day9
+
+import (
+	"io"
+
+	"aoc2023/aoc"
+)
+
+func parseHistory(line string) ([]int, error) {
+	seq, err := aoc.ParseInts(line)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(seq) == 0 {
+		return nil, aoc.Errorf(1, "empty history")
+	}
+
+	return seq, nil
+}
+
+func extrapolate(seq []int) int {
+	diff, zero := diffSlice(seq)
+	lastNumber := seq[len(seq)-1]
+
+	if zero {
+		return lastNumber
+	}
+	return extrapolate(diff) + lastNumber
+}
+
+func extrapolateBackward(seq []int) int {
+	diff, zero := diffSlice(seq)
+	firstNumber := seq[0]
+
+	if zero {
+		return firstNumber
+	}
+	return firstNumber - extrapolateBackward(diff)
+}
+
+func diffSlice(seq []int) ([]int, bool) {
+	result := make([]int, 0)
+	allZero := true
+
+	for i := 1; i < len(seq); i++ {
+		diff := seq[i] - seq[i-1]
+		result = append(result, diff)
+
+		if diff != 0 {
+			allZero = false
+		}
+	}
+
+	return result, allZero
+}
+
+// readHistories returns the histories of all lines.
+func readHistories(r io.Reader) ([][]int, error) {
+	lines, err := aoc.ReadLines(r)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([][]int, 0, len(lines))
+	for i, line := range lines {
+		seq, err := parseHistory(line)
+		if err != nil {
+			return nil, aoc.AtLine(i+1, line, err)
+		}
+
+		result = append(result, seq)
+	}
+
+	return result, nil
+}
+
+// Solve1 returns the sum of the extrapolated next values of all histories.
+func Solve1(histories [][]int) (aoc.Answer, error) {
+	sum := 0
+	for _, seq := range histories {
+		sum += extrapolate(seq)
+	}
+
+	return aoc.Int(sum), nil
+}
+
+// Solve2 returns the sum of the extrapolated previous values of all histories.
+func Solve2(histories [][]int) (aoc.Answer, error) {
+	sum := 0
+	for _, seq := range histories {
+		sum += extrapolateBackward(seq)
+	}
+
+	return aoc.Int(sum), nil
+}
+
+func init() {
+	aoc.RegisterParsed(9, readHistories, Solve1, Solve2)
+}
@@ -0,0 +1,28 @@
+package day9
+
+import (
+	"embed"
+	"testing"
+
+	"aoc2023/aoc/aoctest"
+)
+
+//go:embed example*
+var exampleFiles embed.FS
+
+var examples = []aoctest.Example{
+	{File: "example1", Part: 1, Want: "114"},
+	{File: "example1", Part: 2, Want: "2"},
+}
+
+func TestExamples(t *testing.T) {
+	aoctest.Run(t, 9, exampleFiles, examples)
+}
+
+func BenchmarkDay9Part1(b *testing.B) {
+	aoctest.Benchmark(b, 9, 1, exampleFiles, examples)
+}
+
+func BenchmarkDay9Part2(b *testing.B) {
+	aoctest.Benchmark(b, 9, 2, exampleFiles, examples)
+}